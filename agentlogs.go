@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
+
+	"skywalking_transformer/converter"
+	"skywalking_transformer/metrics"
+	agentv3 "skywalking_transformer/skywalking/v3"
+)
+
+// ----------- Agent logs -----------
+
+func enqueueLog(entry *agentv3.LogData, deadline time.Time) bool {
+	return enqueueReport(entry.GetService(), entry.GetServiceInstance(), converter.LogToOtel(entry), deadline)
+}
+
+func logsHandler(c *gin.Context) {
+	body, ok := readAgentBody(c)
+	if !ok {
+		return
+	}
+	entries, err := decodeAgentList[agentv3.LogData](body, c.ContentType())
+	if err != nil {
+		log.Printf("Bind error: %v", err)
+		metrics.ConversionFailures.WithLabelValues(c.FullPath(), "decode").Inc()
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	metrics.LogsReceived.WithLabelValues(c.FullPath()).Add(float64(len(entries)))
+
+	deadline := enqueueDeadline()
+	enqueued := 0
+	for _, entry := range entries {
+		if enqueueLog(entry, deadline) {
+			enqueued++
+		}
+	}
+	rejected := len(entries) - enqueued
+	if rejected > 0 && queueFullMode == queueFullReject {
+		c.Header("Retry-After", strconv.Itoa(rejectRetryAfter))
+		c.JSON(rejectStatus, gin.H{"status": "rejected", "accepted": enqueued, "rejected": rejected})
+		return
+	}
+	c.JSON(200, gin.H{"status": "queued", "accepted": enqueued, "rejected": rejected})
+}
+
+// logReportService is the gRPC counterpart of /v3/logs.
+type logReportService struct {
+	agentv3.UnimplementedLogReportServiceServer
+}
+
+func (s *logReportService) Collect(stream agentv3.LogReportService_CollectServer) error {
+	for {
+		entry, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&agentv3.Commands{})
+		}
+		if err != nil {
+			return err
+		}
+		metrics.LogsReceived.WithLabelValues("grpc:logs").Inc()
+		if !enqueueLog(entry, enqueueDeadline()) && queueFullMode == queueFullReject {
+			// ends the stream; the agent reconnects and resends later
+			return status.Error(rejectCode(), "queue full, retry later")
+		}
+	}
+}
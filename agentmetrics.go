@@ -0,0 +1,270 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"skywalking_transformer/converter"
+	"skywalking_transformer/metrics"
+	"skywalking_transformer/otel"
+	agentv3 "skywalking_transformer/skywalking/v3"
+)
+
+// ----------- Agent metrics (JVM, CLR, Meter API) -----------
+
+// enqueueReport routes data converted from one report of a service instance.
+// Like enqueueSegment it reports false when a queue rejected it.
+func enqueueReport(service, inst string, payload otel.OTelPayload, deadline time.Time) bool {
+	if len(payload.ResourceSpans)+len(payload.ResourceLogs)+len(payload.ResourceMetrics) == 0 {
+		return true
+	}
+	svc, _ := converter.ParseService(service)
+	targets := routeService(svc, inst)
+	if len(targets) == 0 {
+		countUnrouted()
+		return true
+	}
+	return enqueuePayload(payload, targets, deadline)
+}
+
+// enqueueMetrics is enqueueReport for converted agent metrics.
+func enqueueMetrics(service, inst string, payload otel.OTelPayload, deadline time.Time) bool {
+	metrics.DataPointsConverted.Add(float64(payload.DataPointCount()))
+	return enqueueReport(service, inst, payload, deadline)
+}
+
+// readAgentBody reads a request body, answering the agent itself on failure.
+func readAgentBody(c *gin.Context) ([]byte, bool) {
+	body, err := c.GetRawData()
+	if err != nil {
+		if isBodyTooLarge(err) {
+			metrics.ConversionFailures.WithLabelValues(c.FullPath(), "too_large").Inc()
+			c.JSON(413, gin.H{"error": "decompressed body too large"})
+			return nil, false
+		}
+		metrics.ConversionFailures.WithLabelValues(c.FullPath(), "read").Inc()
+		c.JSON(400, gin.H{"error": "failed to read body"})
+		return nil, false
+	}
+	return body, true
+}
+
+// bindAgentMessage decodes a report sent over HTTP: protobuf when the agent
+// says so, else the protocol's JSON mapping.
+func bindAgentMessage(c *gin.Context, msg proto.Message) bool {
+	body, ok := readAgentBody(c)
+	if !ok {
+		return false
+	}
+	var err error
+	if strings.Contains(c.ContentType(), "protobuf") {
+		err = proto.Unmarshal(body, msg)
+	} else {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, msg)
+	}
+	if err != nil {
+		log.Printf("Bind error: %v", err)
+		metrics.ConversionFailures.WithLabelValues(c.FullPath(), "decode").Inc()
+		c.JSON(400, gin.H{"error": err.Error()})
+		return false
+	}
+	return true
+}
+
+// decodeAgentList reads a list of reports sent over HTTP: a JSON array (or a
+// single object) in the protocol's JSON mapping, or one protobuf message.
+func decodeAgentList[T any, M interface {
+	*T
+	proto.Message
+}](body []byte, contentType string) ([]M, error) {
+	if strings.Contains(contentType, "protobuf") {
+		msg := M(new(T))
+		if err := proto.Unmarshal(body, msg); err != nil {
+			return nil, err
+		}
+		return []M{msg}, nil
+	}
+	body = bytes.TrimSpace(body)
+	raw := []json.RawMessage{body}
+	if bytes.HasPrefix(body, []byte("[")) {
+		if err := json.Unmarshal(body, &raw); err != nil {
+			return nil, err
+		}
+	}
+	out := make([]M, 0, len(raw))
+	for i, r := range raw {
+		msg := M(new(T))
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(r, msg); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		out = append(out, msg)
+	}
+	return out, nil
+}
+
+// respondMetrics answers a metric report like collectAndEnqueueHandler.
+func respondMetrics(c *gin.Context, queued bool) {
+	if !queued && queueFullMode == queueFullReject {
+		c.Header("Retry-After", strconv.Itoa(rejectRetryAfter))
+		c.JSON(rejectStatus, gin.H{"status": "rejected"})
+		return
+	}
+	c.JSON(200, gin.H{"status": "queued"})
+}
+
+func jvmMetricsHandler(c *gin.Context) {
+	var in agentv3.JVMMetricCollection
+	if !bindAgentMessage(c, &in) {
+		return
+	}
+	metrics.MetricReportsReceived.WithLabelValues(c.FullPath()).Inc()
+	payload := converter.JVMMetricsToOtel(&in)
+	respondMetrics(c, enqueueMetrics(in.GetService(), in.GetServiceInstance(), payload, enqueueDeadline()))
+}
+
+// jvmMetricService is the gRPC counterpart of /v3/jvmMetrics.
+type jvmMetricService struct {
+	agentv3.UnimplementedJVMMetricReportServiceServer
+}
+
+func (s *jvmMetricService) Collect(_ context.Context, in *agentv3.JVMMetricCollection) (*agentv3.Commands, error) {
+	metrics.MetricReportsReceived.WithLabelValues("grpc:jvmMetrics").Inc()
+	payload := converter.JVMMetricsToOtel(in)
+	if !enqueueMetrics(in.GetService(), in.GetServiceInstance(), payload, enqueueDeadline()) &&
+		queueFullMode == queueFullReject {
+		return nil, status.Error(rejectCode(), "queue full, retry later")
+	}
+	return &agentv3.Commands{}, nil
+}
+
+func clrMetricReportsHandler(c *gin.Context) {
+	var in agentv3.CLRMetricCollection
+	if !bindAgentMessage(c, &in) {
+		return
+	}
+	metrics.MetricReportsReceived.WithLabelValues(c.FullPath()).Inc()
+	payload := converter.CLRMetricsToOtel(&in)
+	respondMetrics(c, enqueueMetrics(in.GetService(), in.GetServiceInstance(), payload, enqueueDeadline()))
+}
+
+// clrMetricService is the gRPC counterpart of /v3/clrMetricReports.
+type clrMetricService struct {
+	agentv3.UnimplementedCLRMetricReportServiceServer
+}
+
+func (s *clrMetricService) Collect(_ context.Context, in *agentv3.CLRMetricCollection) (*agentv3.Commands, error) {
+	metrics.MetricReportsReceived.WithLabelValues("grpc:clrMetrics").Inc()
+	payload := converter.CLRMetricsToOtel(in)
+	if !enqueueMetrics(in.GetService(), in.GetServiceInstance(), payload, enqueueDeadline()) &&
+		queueFullMode == queueFullReject {
+		return nil, status.Error(rejectCode(), "queue full, retry later")
+	}
+	return &agentv3.Commands{}, nil
+}
+
+// meterCollectHandler takes one report as a list of MeterData.
+func meterCollectHandler(c *gin.Context) {
+	body, ok := readAgentBody(c)
+	if !ok {
+		return
+	}
+	data, err := decodeAgentList[agentv3.MeterData](body, c.ContentType())
+	if err != nil {
+		log.Printf("Bind error: %v", err)
+		metrics.ConversionFailures.WithLabelValues(c.FullPath(), "decode").Inc()
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	metrics.MetricReportsReceived.WithLabelValues(c.FullPath()).Inc()
+	respondMetrics(c, enqueueMeter(data, enqueueDeadline()))
+}
+
+// meterCollectBatchHandler takes a list of MeterDataCollection, one report each.
+func meterCollectBatchHandler(c *gin.Context) {
+	body, ok := readAgentBody(c)
+	if !ok {
+		return
+	}
+	collections, err := decodeAgentList[agentv3.MeterDataCollection](body, c.ContentType())
+	if err != nil {
+		log.Printf("Bind error: %v", err)
+		metrics.ConversionFailures.WithLabelValues(c.FullPath(), "decode").Inc()
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	metrics.MetricReportsReceived.WithLabelValues(c.FullPath()).Add(float64(len(collections)))
+	deadline := enqueueDeadline()
+	enqueued := 0
+	for _, collection := range collections {
+		if enqueueMeter(collection.GetMeterData(), deadline) {
+			enqueued++
+		}
+	}
+	rejected := len(collections) - enqueued
+	if rejected > 0 && queueFullMode == queueFullReject {
+		c.Header("Retry-After", strconv.Itoa(rejectRetryAfter))
+		c.JSON(rejectStatus, gin.H{"status": "rejected", "accepted": enqueued, "rejected": rejected})
+		return
+	}
+	c.JSON(200, gin.H{"status": "queued", "accepted": enqueued, "rejected": rejected})
+}
+
+func enqueueMeter(data []*agentv3.MeterData, deadline time.Time) bool {
+	service, inst, payload := converter.MeterToOtel(data)
+	return enqueueMetrics(service, inst, payload, deadline)
+}
+
+// meterService is the gRPC counterpart of /v3/meter/*.
+type meterService struct {
+	agentv3.UnimplementedMeterReportServiceServer
+}
+
+// Collect treats the whole stream as one report.
+func (s *meterService) Collect(stream agentv3.MeterReportService_CollectServer) error {
+	var data []*agentv3.MeterData
+	for {
+		d, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		data = append(data, d)
+	}
+	metrics.MetricReportsReceived.WithLabelValues("grpc:meter").Inc()
+	if !enqueueMeter(data, enqueueDeadline()) && queueFullMode == queueFullReject {
+		return status.Error(rejectCode(), "queue full, retry later")
+	}
+	return stream.SendAndClose(&agentv3.Commands{})
+}
+
+func (s *meterService) CollectBatch(stream agentv3.MeterReportService_CollectBatchServer) error {
+	for {
+		collection, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&agentv3.Commands{})
+		}
+		if err != nil {
+			return err
+		}
+		metrics.MetricReportsReceived.WithLabelValues("grpc:meterBatch").Inc()
+		if !enqueueMeter(collection.GetMeterData(), enqueueDeadline()) && queueFullMode == queueFullReject {
+			// ends the stream; the agent reconnects and resends later
+			return status.Error(rejectCode(), "queue full, retry later")
+		}
+	}
+}
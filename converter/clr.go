@@ -0,0 +1,53 @@
+package converter
+
+import (
+	"skywalking_transformer/otel"
+	agentv3 "skywalking_transformer/skywalking/v3"
+)
+
+// ----------- CLR metrics -----------
+
+// CLRMetricsToOtel converts a .NET agent's metric report into OTLP metrics
+// named like the OpenTelemetry .NET runtime instrumentation
+// (process.runtime.dotnet.*). It returns an empty payload when the report
+// has no metrics.
+func CLRMetricsToOtel(in *agentv3.CLRMetricCollection) otel.OTelPayload {
+	svc, _ := ParseService(in.GetService())
+	instance := in.GetServiceInstance()
+	b := metricBuilder{series: seriesKey(in.GetService(), instance)}
+	for _, m := range in.GetMetrics() {
+		ts := metricTime(m.GetTime())
+		if cpu := m.GetCpu(); cpu != nil {
+			b.add("process.runtime.dotnet.cpu.utilization", "1", kindGauge, doublePoint(ts, cpu.GetUsagePercent()/100))
+		}
+		if gc := m.GetGc(); gc != nil {
+			// agents report the collections since their previous report
+			key := seriesKey(in.GetService(), instance, "clr.gc")
+			for _, gen := range []struct {
+				name  string
+				count int64
+			}{
+				{"gen0", gc.GetGen0CollectCount()},
+				{"gen1", gc.GetGen1CollectCount()},
+				{"gen2", gc.GetGen2CollectCount()},
+			} {
+				b.add("process.runtime.dotnet.gc.collections.count", "{collection}", kindCounter,
+					cumulativePoint(key+gen.name, gen.count, ts, strAttr("generation", gen.name)))
+			}
+			b.add("process.runtime.dotnet.gc.objects.size", "By", kindUpDown, intPoint(ts, gc.GetHeapMemory()))
+		}
+		if t := m.GetThread(); t != nil {
+			worker := strAttr("type", "worker")
+			completionPort := strAttr("type", "completion_port")
+			b.add("process.runtime.dotnet.thread_pool.threads.available", "{thread}", kindUpDown,
+				intPoint(ts, int64(t.GetAvailableWorkerThreads()), worker))
+			b.add("process.runtime.dotnet.thread_pool.threads.available", "{thread}", kindUpDown,
+				intPoint(ts, int64(t.GetAvailableCompletionPortThreads()), completionPort))
+			b.add("process.runtime.dotnet.thread_pool.threads.max", "{thread}", kindUpDown,
+				intPoint(ts, int64(t.GetMaxWorkerThreads()), worker))
+			b.add("process.runtime.dotnet.thread_pool.threads.max", "{thread}", kindUpDown,
+				intPoint(ts, int64(t.GetMaxCompletionPortThreads()), completionPort))
+		}
+	}
+	return b.payload(buildResource(svc, instance, "dotnet", ""))
+}
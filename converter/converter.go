@@ -2,12 +2,14 @@ package converter
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log"
 	"skywalking_transformer/otel"
 	"skywalking_transformer/skywalking"
+	"strconv"
 	"strings"
 )
 
@@ -21,44 +23,112 @@ func randomTraceID() string {
 	return hex.EncodeToString(b)
 }
 
-func randomSpanID() string {
-	b := make([]byte, 8)
-	if _, err := rand.Read(b); err != nil {
-		log.Printf("Failed to generate random span ID: %v", err)
-		// Fallback to a default value
-		return ""
+// TraceID maps a SkyWalking trace ID onto a 16-byte OTLP trace ID.
+//
+// The mapping is deterministic so that every segment of a distributed trace,
+// whichever agent reported it, lands under the same OTLP trace. IDs that are
+// already 32 hex digits (optionally UUID-dashed) are kept as-is; anything else,
+// such as the dotted "<instance>.<thread>.<time>" form, is hashed. An empty ID
+// falls back to a random one.
+func TraceID(swTraceID string) string {
+	if swTraceID == "" {
+		return randomTraceID()
 	}
-	return hex.EncodeToString(b)
+	if id := strings.ToLower(strings.ReplaceAll(swTraceID, "-", "")); isHex(id, 32) {
+		return id
+	}
+	sum := sha256.Sum256([]byte(swTraceID))
+	return hex.EncodeToString(sum[:16])
+}
+
+// SpanID computes the OTLP span ID of a SkyWalking span from its segment ID and
+// in-segment span ID. Any segment can therefore derive the ID of a span it did
+// not contain itself (e.g. its cross-process parent), and re-sending a segment
+// yields the same IDs again.
+func SpanID(segmentID string, spanID int) string {
+	sum := sha256.Sum256([]byte(segmentID + "/" + strconv.Itoa(spanID)))
+	if id := hex.EncodeToString(sum[:8]); isHex(id, 16) {
+		return id
+	}
+	// all-zero is not a valid span ID; astronomically unlikely, but be safe
+	return hex.EncodeToString(sum[8:16])
 }
 
-// struct to parse the service field
-type serviceParsed struct {
+// isHex reports whether s is exactly n lowercase hex digits and not all zero,
+// an all-zero ID being invalid in OTLP.
+func isHex(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	nonZero := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '0':
+		case c >= '1' && c <= '9', c >= 'a' && c <= 'f':
+			nonZero = true
+		default:
+			return false
+		}
+	}
+	return nonZero
+}
+
+// Service is the service identity CodeXray agents send as a single-quoted
+// JSON string, e.g. "{'name':'billing','teamID':'acme','type':'java'}".
+type Service struct {
 	Name   string `json:"name"`
 	TeamID string `json:"teamID"`
 	Type   string `json:"type"`
 }
 
+// ParseService decodes the service field. Stock agents send a plain name; on
+// a decode error the raw value is returned as Name along with the error.
+func ParseService(raw string) (Service, error) {
+	corrected := strings.ReplaceAll(raw, "'", "\"")
+	var parsed Service
+	if err := json.Unmarshal([]byte(corrected), &parsed); err != nil {
+		return Service{Name: raw}, err
+	}
+	return parsed, nil
+}
+
 func SkywalkingToOtel(sw *skywalking.TraceSegment) otel.OTelPayload {
-	traceID := randomTraceID()
-	spanIDMap := make(map[int]string)
+	traceID := TraceID(sw.TraceID)
+	// without a segment ID span IDs can only be unique, not reproducible
+	segmentID := sw.TraceSegmentId
+	if segmentID == "" {
+		segmentID = randomTraceID()
+	}
 	var otelSpans []otel.OTelSpan
 
-	// parse the sw.Service string
-	corrected := strings.ReplaceAll(sw.Service, "'", "\"")
-	var parsed serviceParsed
-	if err := json.Unmarshal([]byte(corrected), &parsed); err != nil {
-		log.Printf("Failed to parse service field, fallback to raw: %v", err)
-		parsed.Name = sw.Service
-	}
+	// stock agents send a plain name, which ParseService keeps as Name
+	parsed, _ := ParseService(sw.Service)
 
 	for i := range sw.Spans {
 		swSpan := &sw.Spans[i]
-		hexSpanID := randomSpanID()
-		spanIDMap[swSpan.SpanID] = hexSpanID
+		hexSpanID := SpanID(segmentID, swSpan.SpanID)
 
 		parentHexID := ""
+		parentRef := parentReference(swSpan.References)
 		if swSpan.ParentSpanID >= 0 {
-			parentHexID = spanIDMap[swSpan.ParentSpanID]
+			parentHexID = SpanID(segmentID, swSpan.ParentSpanID)
+			parentRef = nil
+		} else if parentRef != nil {
+			// segment root continuing a span from another segment (usually
+			// another service): link it to that span's deterministic ID
+			parentHexID = SpanID(parentRef.ParentTraceSegmentId, parentRef.ParentSpanId)
+		}
+
+		// only one reference can be the parent; the rest (batch consumers,
+		// MQ fan-in) become span links
+		var links []otel.Link
+		for k := range swSpan.References {
+			ref := &swSpan.References[k]
+			if ref == parentRef || ref.ParentTraceSegmentId == "" {
+				continue
+			}
+			links = append(links, referenceLink(ref, traceID))
 		}
 
 		var attributes []otel.Attribute
@@ -104,6 +174,24 @@ func SkywalkingToOtel(sw *skywalking.TraceSegment) otel.OTelPayload {
 			}
 		}
 
+		// keep the original SkyWalking IDs for lookups from the agent side
+		if sw.TraceID != "" {
+			attributes = append(attributes, otel.Attribute{
+				Key: "skywalking.trace_id",
+				Value: otel.AttributeVal{
+					StringValue: sw.TraceID,
+				},
+			})
+		}
+		if sw.TraceSegmentId != "" {
+			attributes = append(attributes, otel.Attribute{
+				Key: "skywalking.segment_id",
+				Value: otel.AttributeVal{
+					StringValue: sw.TraceSegmentId,
+				},
+			})
+		}
+
 		// Add span type and error status in one append (combined)
 		attributes = append(attributes,
 			otel.Attribute{
@@ -187,6 +275,7 @@ func SkywalkingToOtel(sw *skywalking.TraceSegment) otel.OTelPayload {
 			EndTimeUnixNano:   formatNano(swSpan.EndTime),
 			Attributes:        attributes,
 			Events:            events,
+			Links:             links,
 			Status:            status,
 		}
 
@@ -195,20 +284,7 @@ func SkywalkingToOtel(sw *skywalking.TraceSegment) otel.OTelPayload {
 
 	resourceSpans := otel.ResourceSpan{
 		Resource: otel.Resource{
-			Attributes: []otel.Attribute{
-				{
-					Key: "service.name",
-					Value: otel.AttributeVal{
-						StringValue: parsed.Name,
-					},
-				},
-				{
-					Key: "service.instance.id",
-					Value: otel.AttributeVal{
-						StringValue: sw.ServiceInstance,
-					},
-				},
-			},
+			Attributes: resourceAttributes(sw, parsed),
 		},
 		ScopeSpans: []otel.ScopeSpans{
 			{
@@ -222,6 +298,59 @@ func SkywalkingToOtel(sw *skywalking.TraceSegment) otel.OTelPayload {
 	}
 }
 
+// parentReference returns the reference that becomes the OTLP parent of a
+// span, i.e. the first one that names a parent segment.
+func parentReference(refs []skywalking.Reference) *skywalking.Reference {
+	for i := range refs {
+		if refs[i].ParentTraceSegmentId != "" {
+			return &refs[i]
+		}
+	}
+	return nil
+}
+
+// referenceLink builds the span link for a secondary reference. References to
+// another trace keep that trace's ID.
+func referenceLink(ref *skywalking.Reference, traceID string) otel.Link {
+	if ref.TraceId != "" {
+		traceID = TraceID(ref.TraceId)
+	}
+	refType := ref.RefType
+	if refType == "" {
+		// proto3 JSON omits the zero enum value
+		refType = skywalking.RefTypeCrossProcess
+	}
+	attributes := []otel.Attribute{
+		{
+			Key: "skywalking.ref_type",
+			Value: otel.AttributeVal{
+				StringValue: string(refType),
+			},
+		},
+	}
+	if ref.ParentEndpoint != "" {
+		attributes = append(attributes, otel.Attribute{
+			Key: "skywalking.parent_endpoint",
+			Value: otel.AttributeVal{
+				StringValue: ref.ParentEndpoint,
+			},
+		})
+	}
+	if ref.ParentService != "" {
+		attributes = append(attributes, otel.Attribute{
+			Key: "skywalking.parent_service",
+			Value: otel.AttributeVal{
+				StringValue: ref.ParentService,
+			},
+		})
+	}
+	return otel.Link{
+		TraceID:    traceID,
+		SpanID:     SpanID(ref.ParentTraceSegmentId, ref.ParentSpanId),
+		Attributes: attributes,
+	}
+}
+
 func formatNano(ms int64) string {
 	return fmt.Sprintf("%d", ms*1_000_000)
 }
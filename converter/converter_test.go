@@ -0,0 +1,299 @@
+package converter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"skywalking_transformer/otel"
+	"skywalking_transformer/skywalking"
+	agentv3 "skywalking_transformer/skywalking/v3"
+)
+
+func TestTraceID(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"hex kept", "0123456789abcdef0123456789abcdef", "0123456789abcdef0123456789abcdef"},
+		{"uppercase lowered", "0123456789ABCDEF0123456789ABCDEF", "0123456789abcdef0123456789abcdef"},
+		{"uuid dashes removed", "01234567-89ab-cdef-0123-456789abcdef", "0123456789abcdef0123456789abcdef"},
+		{"dotted hashed", "a1b2c3.45.16000000000001", hashTraceID("a1b2c3.45.16000000000001")},
+		{"all zero hashed", "00000000000000000000000000000000", hashTraceID("00000000000000000000000000000000")},
+		{"too short hashed", "abc123", hashTraceID("abc123")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TraceID(tt.in)
+			if got != tt.want {
+				t.Errorf("TraceID(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+			if again := TraceID(tt.in); again != got {
+				t.Errorf("TraceID(%q) not deterministic: %q then %q", tt.in, got, again)
+			}
+		})
+	}
+}
+
+func TestTraceIDEmptyIsRandom(t *testing.T) {
+	a, b := TraceID(""), TraceID("")
+	if !isHex(a, 32) || !isHex(b, 32) {
+		t.Fatalf("random IDs %q, %q are not 32 hex digits", a, b)
+	}
+	if a == b {
+		t.Errorf("two empty trace IDs both mapped to %q", a)
+	}
+}
+
+func hashTraceID(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:16])
+}
+
+func TestSpanID(t *testing.T) {
+	sum := sha256.Sum256([]byte("seg-1/2"))
+	tests := []struct {
+		name      string
+		segmentID string
+		spanID    int
+		want      string
+	}{
+		{"hash of segment and span", "seg-1", 2, hex.EncodeToString(sum[:8])},
+		{"other span", "seg-1", 3, SpanID("seg-1", 3)},
+		{"other segment", "seg-2", 2, SpanID("seg-2", 2)},
+	}
+	seen := map[string]string{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SpanID(tt.segmentID, tt.spanID)
+			if got != tt.want {
+				t.Errorf("SpanID(%q, %d) = %q, want %q", tt.segmentID, tt.spanID, got, tt.want)
+			}
+			if !isHex(got, 16) {
+				t.Errorf("SpanID(%q, %d) = %q, not 16 hex digits", tt.segmentID, tt.spanID, got)
+			}
+			if prev, ok := seen[got]; ok {
+				t.Errorf("SpanID(%q, %d) collides with %s", tt.segmentID, tt.spanID, prev)
+			}
+			seen[got] = tt.name
+		})
+	}
+}
+
+func TestSegmentSpanIDsAreReproducible(t *testing.T) {
+	segment := &skywalking.TraceSegment{
+		TraceID:        "trace-1",
+		Service:        "svc",
+		TraceSegmentId: "seg-1",
+		Spans: []skywalking.Span{
+			{SpanID: 0, ParentSpanID: -1, OperationName: "entry"},
+			{SpanID: 1, ParentSpanID: 0, OperationName: "exit"},
+		},
+	}
+	first := spansOf(SkywalkingToOtel(segment))
+	again := spansOf(SkywalkingToOtel(segment))
+	if len(first) != 2 {
+		t.Fatalf("got %d spans, want 2", len(first))
+	}
+	for i := range first {
+		if first[i].SpanID != again[i].SpanID || first[i].TraceID != again[i].TraceID {
+			t.Errorf("span %d: IDs changed on resend: %s/%s then %s/%s", i,
+				first[i].TraceID, first[i].SpanID, again[i].TraceID, again[i].SpanID)
+		}
+		if want := SpanID("seg-1", i); first[i].SpanID != want {
+			t.Errorf("span %d: SpanID = %s, want %s", i, first[i].SpanID, want)
+		}
+	}
+	if first[0].ParentSpanID != "" {
+		t.Errorf("root span has parent %s", first[0].ParentSpanID)
+	}
+	if first[1].ParentSpanID != first[0].SpanID {
+		t.Errorf("child parent = %s, want %s", first[1].ParentSpanID, first[0].SpanID)
+	}
+}
+
+func spansOf(p otel.OTelPayload) []otel.OTelSpan {
+	var spans []otel.OTelSpan
+	for _, rs := range p.ResourceSpans {
+		for _, ss := range rs.ScopeSpans {
+			spans = append(spans, ss.Spans...)
+		}
+	}
+	return spans
+}
+
+func TestParentReference(t *testing.T) {
+	tests := []struct {
+		name string
+		refs []skywalking.Reference
+		want int // index into refs, -1 for none
+	}{
+		{"none", nil, -1},
+		{"first", []skywalking.Reference{{ParentTraceSegmentId: "a"}, {ParentTraceSegmentId: "b"}}, 0},
+		{"skips refs without segment", []skywalking.Reference{{TraceId: "t"}, {ParentTraceSegmentId: "b"}}, 1},
+		{"no usable ref", []skywalking.Reference{{TraceId: "t"}}, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parentReference(tt.refs)
+			switch {
+			case tt.want < 0 && got != nil:
+				t.Errorf("got %+v, want nil", *got)
+			case tt.want >= 0 && got != &tt.refs[tt.want]:
+				t.Errorf("got %v, want refs[%d]", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrossSegmentParent(t *testing.T) {
+	refs := []skywalking.Reference{
+		{RefType: skywalking.RefTypeCrossProcess, TraceId: "trace-1", ParentTraceSegmentId: "parent-seg", ParentSpanId: 3},
+	}
+	tests := []struct {
+		name         string
+		parentSpanID int
+		want         string
+	}{
+		{"segment root continues the referenced span", -1, SpanID("parent-seg", 3)},
+		{"in-segment parent wins over references", 0, SpanID("child-seg", 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			segment := &skywalking.TraceSegment{
+				TraceID:        "trace-1",
+				TraceSegmentId: "child-seg",
+				Spans: []skywalking.Span{
+					{SpanID: 0, ParentSpanID: -1},
+					{SpanID: 1, ParentSpanID: tt.parentSpanID, References: refs},
+				},
+			}
+			span := spansOf(SkywalkingToOtel(segment))[1]
+			if span.ParentSpanID != tt.want {
+				t.Errorf("ParentSpanID = %s, want %s", span.ParentSpanID, tt.want)
+			}
+			if len(span.Links) != 0 && tt.parentSpanID < 0 {
+				t.Errorf("parent reference also became %d links", len(span.Links))
+			}
+		})
+	}
+}
+
+func TestReferenceLink(t *testing.T) {
+	tests := []struct {
+		name      string
+		ref       skywalking.Reference
+		wantTrace string
+		wantAttrs map[string]string
+	}{
+		{
+			name:      "same trace, default ref type",
+			ref:       skywalking.Reference{ParentTraceSegmentId: "seg-a", ParentSpanId: 1},
+			wantTrace: "own",
+			wantAttrs: map[string]string{"skywalking.ref_type": "CrossProcess"},
+		},
+		{
+			name: "other trace with parent details",
+			ref: skywalking.Reference{
+				RefType: skywalking.RefTypeCrossThread, TraceId: "trace-2", ParentTraceSegmentId: "seg-b",
+				ParentSpanId: 4, ParentEndpoint: "/orders", ParentService: "billing",
+			},
+			wantTrace: TraceID("trace-2"),
+			wantAttrs: map[string]string{
+				"skywalking.ref_type":        "CrossThread",
+				"skywalking.parent_endpoint": "/orders",
+				"skywalking.parent_service":  "billing",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			link := referenceLink(&tt.ref, "own")
+			if link.TraceID != tt.wantTrace {
+				t.Errorf("TraceID = %s, want %s", link.TraceID, tt.wantTrace)
+			}
+			if want := SpanID(tt.ref.ParentTraceSegmentId, tt.ref.ParentSpanId); link.SpanID != want {
+				t.Errorf("SpanID = %s, want %s", link.SpanID, want)
+			}
+			got := map[string]string{}
+			for _, a := range link.Attributes {
+				got[a.Key] = a.Value.StringValue
+			}
+			if len(got) != len(tt.wantAttrs) {
+				t.Errorf("attributes = %v, want %v", got, tt.wantAttrs)
+			}
+			for k, v := range tt.wantAttrs {
+				if got[k] != v {
+					t.Errorf("attribute %s = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestSecondaryReferencesBecomeLinks(t *testing.T) {
+	segment := &skywalking.TraceSegment{
+		TraceID:        "trace-1",
+		TraceSegmentId: "consumer-seg",
+		Spans: []skywalking.Span{{
+			SpanID:       0,
+			ParentSpanID: -1,
+			References: []skywalking.Reference{
+				{ParentTraceSegmentId: "producer-1", ParentSpanId: 2},
+				{TraceId: "no-segment"},
+				{ParentTraceSegmentId: "producer-2", ParentSpanId: 5},
+			},
+		}},
+	}
+	span := spansOf(SkywalkingToOtel(segment))[0]
+	if want := SpanID("producer-1", 2); span.ParentSpanID != want {
+		t.Errorf("ParentSpanID = %s, want %s", span.ParentSpanID, want)
+	}
+	if len(span.Links) != 1 {
+		t.Fatalf("got %d links, want 1", len(span.Links))
+	}
+	if want := SpanID("producer-2", 5); span.Links[0].SpanID != want {
+		t.Errorf("link SpanID = %s, want %s", span.Links[0].SpanID, want)
+	}
+	if span.Links[0].TraceID != span.TraceID {
+		t.Errorf("link TraceID = %s, want the span's %s", span.Links[0].TraceID, span.TraceID)
+	}
+}
+
+func TestMeterDropsNonFiniteValues(t *testing.T) {
+	single := func(name string, v float64) *agentv3.MeterData {
+		return &agentv3.MeterData{Metric: &agentv3.MeterData_SingleValue{
+			SingleValue: &agentv3.MeterSingleValue{Name: name, Value: v}}}
+	}
+	histogram := func(name string, bounds ...float64) *agentv3.MeterData {
+		h := &agentv3.MeterHistogram{Name: name}
+		for _, b := range bounds {
+			h.Values = append(h.Values, &agentv3.MeterBucketValue{Bucket: b, Count: 1})
+		}
+		return &agentv3.MeterData{Metric: &agentv3.MeterData_Histogram{Histogram: h}}
+	}
+	_, _, payload := MeterToOtel([]*agentv3.MeterData{
+		{Service: "svc", ServiceInstance: "inst", Timestamp: 1700000000000},
+		single("nan", math.NaN()),
+		single("inf", math.Inf(1)),
+		single("ok", 1.5),
+		histogram("bad_bounds", 0, 10, math.Inf(1)),
+		histogram("latency", 0, 10, 100),
+	})
+	if len(payload.ResourceMetrics) != 1 {
+		t.Fatalf("got %d resource metrics, want 1", len(payload.ResourceMetrics))
+	}
+	var names []string
+	for _, m := range payload.ResourceMetrics[0].ScopeMetrics[0].Metrics {
+		names = append(names, m.Name)
+	}
+	if len(names) != 2 || names[0] != "ok" || names[1] != "latency" {
+		t.Errorf("metrics = %v, want [ok latency]", names)
+	}
+	if _, err := json.Marshal(payload); err != nil {
+		t.Errorf("payload does not encode: %v", err)
+	}
+}
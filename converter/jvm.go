@@ -0,0 +1,121 @@
+package converter
+
+import (
+	"skywalking_transformer/otel"
+	agentv3 "skywalking_transformer/skywalking/v3"
+)
+
+// ----------- JVM metrics -----------
+
+// jvmPools names SkyWalking's memory pool types like the JVM's memory pool
+// MXBeans do (jvm.memory.pool.name); heap tells jvm.memory.type.
+var jvmPools = map[agentv3.PoolType]struct {
+	name string
+	heap bool
+}{
+	agentv3.PoolType_CODE_CACHE_USAGE:                     {"Code Cache", false},
+	agentv3.PoolType_NEWGEN_USAGE:                         {"Eden Space", true},
+	agentv3.PoolType_OLDGEN_USAGE:                         {"Old Gen", true},
+	agentv3.PoolType_SURVIVOR_USAGE:                       {"Survivor Space", true},
+	agentv3.PoolType_PERMGEN_USAGE:                        {"Perm Gen", false},
+	agentv3.PoolType_METASPACE_USAGE:                      {"Metaspace", false},
+	agentv3.PoolType_ZHEAP_USAGE:                          {"ZHeap", true},
+	agentv3.PoolType_COMPRESSED_CLASS_SPACE_USAGE:         {"Compressed Class Space", false},
+	agentv3.PoolType_CODEHEAP_NON_NMETHODS_USAGE:          {"CodeHeap 'non-nmethods'", false},
+	agentv3.PoolType_CODEHEAP_PROFILED_NMETHODS_USAGE:     {"CodeHeap 'profiled nmethods'", false},
+	agentv3.PoolType_CODEHEAP_NON_PROFILED_NMETHODS_USAGE: {"CodeHeap 'non-profiled nmethods'", false},
+}
+
+// jvmGCActions maps GC phases to jvm.gc.action values.
+var jvmGCActions = map[agentv3.GCPhase]string{
+	agentv3.GCPhase_NEW:    "end of minor GC",
+	agentv3.GCPhase_OLD:    "end of major GC",
+	agentv3.GCPhase_NORMAL: "end of GC",
+}
+
+func memoryType(heap bool) otel.Attribute {
+	if heap {
+		return strAttr("jvm.memory.type", "heap")
+	}
+	return strAttr("jvm.memory.type", "non_heap")
+}
+
+// JVMMetricsToOtel converts a Java agent's metric report into OTLP metrics
+// following the jvm.* semantic conventions; GC and thread figures they have
+// no name for go under codexray.jvm.*. It returns an empty payload when the report
+// has no metrics.
+func JVMMetricsToOtel(in *agentv3.JVMMetricCollection) otel.OTelPayload {
+	svc, _ := ParseService(in.GetService())
+	instance := in.GetServiceInstance()
+	b := metricBuilder{series: seriesKey(in.GetService(), instance)}
+	for _, m := range in.GetMetrics() {
+		ts := metricTime(m.GetTime())
+		if cpu := m.GetCpu(); cpu != nil {
+			b.add("jvm.cpu.recent_utilization", "1", kindGauge, doublePoint(ts, cpu.GetUsagePercent()/100))
+		}
+		addMemory := func(used, committed, limit, init int64, attrs ...otel.Attribute) {
+			b.add("jvm.memory.used", "By", kindUpDown, intPoint(ts, used, attrs...))
+			b.add("jvm.memory.committed", "By", kindUpDown, intPoint(ts, committed, attrs...))
+			if limit >= 0 { // -1: undefined
+				b.add("jvm.memory.limit", "By", kindUpDown, intPoint(ts, limit, attrs...))
+			}
+			if init >= 0 {
+				b.add("jvm.memory.init", "By", kindUpDown, intPoint(ts, init, attrs...))
+			}
+		}
+		if pools := m.GetMemoryPool(); len(pools) > 0 {
+			for _, p := range pools {
+				pool, ok := jvmPools[p.GetType()]
+				if !ok {
+					continue
+				}
+				addMemory(p.GetUsed(), p.GetCommitted(), p.GetMax(), p.GetInit(),
+					memoryType(pool.heap), strAttr("jvm.memory.pool.name", pool.name))
+			}
+		} else {
+			// older agents only report heap and non-heap totals
+			for _, mem := range m.GetMemory() {
+				addMemory(mem.GetUsed(), mem.GetCommitted(), mem.GetMax(), mem.GetInit(), memoryType(mem.GetIsHeap()))
+			}
+		}
+		for _, gc := range m.GetGc() {
+			action, ok := jvmGCActions[gc.GetPhase()]
+			if !ok {
+				continue
+			}
+			// agents report the collections and their total time since their
+			// previous report, not single pauses, which jvm.gc.duration would
+			// need; the running totals are counters of their own
+			key := seriesKey(in.GetService(), instance, "jvm.gc", gc.GetPhase().String())
+			attr := strAttr("jvm.gc.action", action)
+			b.add("codexray.jvm.gc.count", "{collection}", kindCounter,
+				cumulativePoint(key+"count", gc.GetCount(), ts, attr))
+			start, elapsed := deltas.add(key+"time", gc.GetTime(), ts)
+			dp := doublePoint(ts, float64(elapsed)/1000, attr)
+			dp.StartTimeUnixNano = start
+			b.add("codexray.jvm.gc.time", "s", kindCounter, dp)
+		}
+		if t := m.GetThread(); t != nil {
+			for _, s := range []struct {
+				state string
+				count int64
+			}{
+				{"runnable", t.GetRunnableStateThreadCount()},
+				{"blocked", t.GetBlockedStateThreadCount()},
+				{"waiting", t.GetWaitingStateThreadCount()},
+				{"timed_waiting", t.GetTimedWaitingStateThreadCount()},
+			} {
+				b.add("jvm.thread.count", "{thread}", kindUpDown, intPoint(ts, s.count, strAttr("jvm.thread.state", s.state)))
+			}
+			// agents do not split the states by jvm.thread.daemon
+			b.add("codexray.jvm.thread.daemon.count", "{thread}", kindUpDown, intPoint(ts, t.GetDaemonCount()))
+			b.add("codexray.jvm.thread.peak.count", "{thread}", kindGauge, intPoint(ts, t.GetPeakCount()))
+		}
+		if c := m.GetClazz(); c != nil {
+			b.add("jvm.class.count", "{class}", kindUpDown, intPoint(ts, c.GetLoadedClassCount()))
+			b.add("jvm.class.loaded", "{class}", kindCounter, intPoint(ts, c.GetTotalLoadedClassCount()))
+			b.add("jvm.class.unloaded", "{class}", kindCounter, intPoint(ts, c.GetTotalUnloadedClassCount()))
+		}
+	}
+	return b.payload(buildResource(svc, instance, "java", ""))
+}
@@ -0,0 +1,85 @@
+package converter
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"skywalking_transformer/otel"
+	agentv3 "skywalking_transformer/skywalking/v3"
+)
+
+// ----------- Logs -----------
+
+// severities maps the level tag agents' log toolkits set to OTLP severity
+// numbers.
+var severities = map[string]int{
+	"TRACE":    otel.SeverityTrace,
+	"FINEST":   otel.SeverityTrace,
+	"FINER":    otel.SeverityTrace,
+	"DEBUG":    otel.SeverityDebug,
+	"FINE":     otel.SeverityDebug,
+	"INFO":     otel.SeverityInfo,
+	"CONFIG":   otel.SeverityInfo,
+	"WARN":     otel.SeverityWarn,
+	"WARNING":  otel.SeverityWarn,
+	"ERROR":    otel.SeverityError,
+	"SEVERE":   otel.SeverityError,
+	"FATAL":    otel.SeverityFatal,
+	"CRITICAL": otel.SeverityFatal,
+}
+
+// LogToOtel converts a SkyWalking log entry into an OTLP log record. Its trace
+// context maps to the IDs SkywalkingToOtel gives the span it was written in.
+func LogToOtel(in *agentv3.LogData) otel.OTelPayload {
+	svc, _ := ParseService(in.GetService())
+	now := strconv.FormatInt(time.Now().UnixNano(), 10)
+	record := otel.LogRecord{ObservedTimeUnixNano: now, TimeUnixNano: now}
+	if in.GetTimestamp() > 0 {
+		record.TimeUnixNano = formatNano(in.GetTimestamp())
+	}
+
+	body := in.GetBody()
+	format := "text"
+	switch {
+	case body.GetJson() != nil:
+		format = "json"
+		record.Body.StringValue = body.GetJson().GetJson()
+	case body.GetYaml() != nil:
+		format = "yaml"
+		record.Body.StringValue = body.GetYaml().GetYaml()
+	default:
+		record.Body.StringValue = body.GetText().GetText()
+	}
+	if t := body.GetType(); t != "" {
+		format = strings.ToLower(t)
+	}
+	record.Attributes = append(record.Attributes, strAttr("skywalking.log.format", format))
+
+	for _, tag := range in.GetTags().GetData() {
+		if strings.EqualFold(tag.GetKey(), "level") {
+			record.SeverityText = strings.ToUpper(tag.GetValue())
+			record.SeverityNumber = severities[record.SeverityText]
+			continue
+		}
+		record.Attributes = append(record.Attributes, strAttr(tag.GetKey(), tag.GetValue()))
+	}
+	if ep := in.GetEndpoint(); ep != "" {
+		record.Attributes = append(record.Attributes, strAttr("skywalking.endpoint", ep))
+	}
+
+	if tc := in.GetTraceContext(); tc.GetTraceId() != "" {
+		record.TraceID = TraceID(tc.GetTraceId())
+		record.Attributes = append(record.Attributes, strAttr("skywalking.trace_id", tc.GetTraceId()))
+		if seg := tc.GetTraceSegmentId(); seg != "" {
+			record.SpanID = SpanID(seg, int(tc.GetSpanId()))
+			record.Attributes = append(record.Attributes, strAttr("skywalking.segment_id", seg))
+		}
+	}
+
+	resource := buildResource(svc, in.GetServiceInstance(), guessLanguage(svc, nil), in.GetLayer())
+	return otel.OTelPayload{ResourceLogs: []otel.ResourceLogs{{
+		Resource:  otel.Resource{Attributes: resource},
+		ScopeLogs: []otel.ScopeLogs{{LogRecords: []otel.LogRecord{record}}},
+	}}}
+}
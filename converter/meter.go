@@ -0,0 +1,72 @@
+package converter
+
+import (
+	"sort"
+
+	"skywalking_transformer/otel"
+	agentv3 "skywalking_transformer/skywalking/v3"
+)
+
+// ----------- Meter API -----------
+
+// MeterToOtel converts one meter report (a collect stream or a
+// MeterDataCollection) into OTLP metrics: single values become gauges,
+// histograms explicit-bucket histograms. Only the first entries of a report
+// carry service, instance and timestamp; the others inherit them. It returns
+// the report's service and instance for routing.
+func MeterToOtel(data []*agentv3.MeterData) (service, instance string, payload otel.OTelPayload) {
+	var b metricBuilder
+	var ms int64
+	for _, d := range data {
+		if service == "" {
+			service, instance = d.GetService(), d.GetServiceInstance()
+			b.series = seriesKey(service, instance)
+		}
+		if d.GetTimestamp() > 0 {
+			ms = d.GetTimestamp()
+		}
+		ts := metricTime(ms)
+		switch {
+		case d.GetSingleValue() != nil:
+			v := d.GetSingleValue()
+			b.add(v.GetName(), "", kindGauge, doublePoint(ts, v.GetValue(), meterLabels(v.GetLabels())...))
+		case d.GetHistogram() != nil:
+			h := d.GetHistogram()
+			b.addHistogram(h.GetName(), "", meterHistogram(ts, h))
+		}
+	}
+	svc, _ := ParseService(service)
+	return service, instance, b.payload(buildResource(svc, instance, guessLanguage(svc, nil), ""))
+}
+
+func meterLabels(labels []*agentv3.Label) []otel.Attribute {
+	attrs := make([]otel.Attribute, 0, len(labels))
+	for _, l := range labels {
+		attrs = append(attrs, strAttr(l.GetName(), l.GetValue()))
+	}
+	return attrs
+}
+
+// meterHistogram turns SkyWalking buckets, keyed by their lower bound, into
+// OTLP ones keyed by their upper bound: every lower bound but the first is the
+// upper bound of the bucket before it. Values sitting exactly on a bound land
+// one bucket lower than the agent counted them.
+func meterHistogram(ts string, h *agentv3.MeterHistogram) otel.HistogramDataPoint {
+	buckets := append([]*agentv3.MeterBucketValue(nil), h.GetValues()...)
+	sort.SliceStable(buckets, func(i, j int) bool {
+		if buckets[i].GetIsNegativeInfinity() != buckets[j].GetIsNegativeInfinity() {
+			return buckets[i].GetIsNegativeInfinity()
+		}
+		return buckets[i].GetBucket() < buckets[j].GetBucket()
+	})
+	dp := otel.HistogramDataPoint{Attributes: meterLabels(h.GetLabels()), TimeUnixNano: ts}
+	for i, bucket := range buckets {
+		if i > 0 {
+			dp.ExplicitBounds = append(dp.ExplicitBounds, bucket.GetBucket())
+		}
+		count := uint64(max(bucket.GetCount(), 0))
+		dp.BucketCounts = append(dp.BucketCounts, count)
+		dp.Count += count
+	}
+	return dp
+}
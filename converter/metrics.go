@@ -0,0 +1,222 @@
+package converter
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"skywalking_transformer/metrics"
+	"skywalking_transformer/otel"
+)
+
+// ----------- Metric helpers -----------
+
+type metricKind int
+
+const (
+	kindGauge     metricKind = iota
+	kindUpDown               // non-monotonic cumulative sum
+	kindCounter              // monotonic cumulative sum
+	kindHistogram            // cumulative explicit-bucket histogram
+)
+
+// metricBuilder collects data points into one Metric per name. Cumulative
+// points without a start time get the time their series was first seen for
+// series, the seriesKey of the reporting instance.
+type metricBuilder struct {
+	series  string
+	metrics []otel.Metric
+	byName  map[string]int
+}
+
+// add appends a data point to the metric name. Points with a NaN or infinite
+// value are dropped: JSON has no encoding for them, and one would fail the
+// whole export batch.
+func (b *metricBuilder) add(name, unit string, kind metricKind, dp otel.NumberDataPoint) {
+	if dp.AsDouble != nil && !isFinite(*dp.AsDouble) {
+		metrics.DataPointsDropped.WithLabelValues("non_finite").Inc()
+		return
+	}
+	if m := b.metric(name, unit, kind); m.Gauge != nil {
+		m.Gauge.DataPoints = append(m.Gauge.DataPoints, dp)
+	} else if m.Sum != nil {
+		if dp.StartTimeUnixNano == "" {
+			value := float64(0)
+			if dp.AsInt != nil {
+				value = float64(*dp.AsInt)
+			} else if dp.AsDouble != nil {
+				value = *dp.AsDouble
+			}
+			dp.StartTimeUnixNano = b.start(name, dp.Attributes, value, m.Sum.IsMonotonic, dp.TimeUnixNano)
+		}
+		m.Sum.DataPoints = append(m.Sum.DataPoints, dp)
+	}
+}
+
+// addHistogram appends a histogram data point, dropping it like add when a
+// bucket bound is not finite.
+func (b *metricBuilder) addHistogram(name, unit string, dp otel.HistogramDataPoint) {
+	if slices.ContainsFunc(dp.ExplicitBounds, func(v float64) bool { return !isFinite(v) }) {
+		metrics.DataPointsDropped.WithLabelValues("non_finite").Inc()
+		return
+	}
+	if m := b.metric(name, unit, kindHistogram); m.Histogram != nil {
+		if dp.StartTimeUnixNano == "" {
+			dp.StartTimeUnixNano = b.start(name, dp.Attributes, float64(dp.Count), true, dp.TimeUnixNano)
+		}
+		m.Histogram.DataPoints = append(m.Histogram.DataPoints, dp)
+	}
+}
+
+// start returns the start time of a cumulative series, see cumulator.start.
+func (b *metricBuilder) start(name string, attrs []otel.Attribute, value float64, monotonic bool, ts string) string {
+	parts := []string{b.series, name}
+	for _, a := range attrs {
+		parts = append(parts, a.Key, a.Value.StringValue)
+	}
+	return deltas.start(seriesKey(parts...), value, monotonic, ts)
+}
+
+// metric returns the metric name, created with kind on first use. A name
+// reused with another kind keeps its first one.
+func (b *metricBuilder) metric(name, unit string, kind metricKind) *otel.Metric {
+	if b.byName == nil {
+		b.byName = map[string]int{}
+	}
+	i, ok := b.byName[name]
+	if !ok {
+		m := otel.Metric{Name: name, Unit: unit}
+		switch kind {
+		case kindGauge:
+			m.Gauge = &otel.Gauge{}
+		case kindHistogram:
+			m.Histogram = &otel.Histogram{AggregationTemporality: otel.TemporalityCumulative}
+		default:
+			m.Sum = &otel.Sum{AggregationTemporality: otel.TemporalityCumulative, IsMonotonic: kind == kindCounter}
+		}
+		b.metrics = append(b.metrics, m)
+		i = len(b.metrics) - 1
+		b.byName[name] = i
+	}
+	return &b.metrics[i]
+}
+
+func (b *metricBuilder) payload(resource []otel.Attribute) otel.OTelPayload {
+	if len(b.metrics) == 0 {
+		return otel.OTelPayload{}
+	}
+	return otel.OTelPayload{ResourceMetrics: []otel.ResourceMetrics{{
+		Resource:     otel.Resource{Attributes: resource},
+		ScopeMetrics: []otel.ScopeMetrics{{Metrics: b.metrics}},
+	}}}
+}
+
+func intPoint(ts string, v int64, attrs ...otel.Attribute) otel.NumberDataPoint {
+	return otel.NumberDataPoint{Attributes: attrs, TimeUnixNano: ts, AsInt: &v}
+}
+
+func doublePoint(ts string, v float64, attrs ...otel.Attribute) otel.NumberDataPoint {
+	return otel.NumberDataPoint{Attributes: attrs, TimeUnixNano: ts, AsDouble: &v}
+}
+
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+func strAttr(key, value string) otel.Attribute {
+	return otel.Attribute{Key: key, Value: otel.AttributeVal{StringValue: value}}
+}
+
+// cumulativePoint adds a delta to the series key and returns the running
+// total as a data point.
+func cumulativePoint(key string, delta int64, ts string, attrs ...otel.Attribute) otel.NumberDataPoint {
+	start, total := deltas.add(key, delta, ts)
+	dp := intPoint(ts, total, attrs...)
+	dp.StartTimeUnixNano = start
+	return dp
+}
+
+// metricTime converts an agent timestamp in ms; agents that leave it unset
+// get the receive time.
+func metricTime(ms int64) string {
+	if ms <= 0 {
+		return strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+	return formatNano(ms)
+}
+
+// ----------- Delta to cumulative -----------
+
+// seriesKey identifies a cumulated series of a service instance.
+func seriesKey(parts ...string) string {
+	return strings.Join(parts, "\x00") + "\x00"
+}
+
+// cumulativeSeriesTTL is how long a series is kept without new reports.
+const cumulativeSeriesTTL = time.Hour
+
+// cumulator turns the per-report deltas some agent metrics carry (GC counts
+// and times) into the cumulative sums most backends expect, and keeps the
+// start time of the totals agents report themselves. Series start when the
+// transformer first sees them, which their start time reflects.
+type cumulator struct {
+	mu        sync.Mutex
+	series    map[string]*cumulativeSeries
+	lastSweep time.Time
+}
+
+type cumulativeSeries struct {
+	start string
+	total int64
+	last  float64 // last reported total, see start
+	seen  time.Time
+}
+
+var deltas = &cumulator{series: map[string]*cumulativeSeries{}}
+
+// add accumulates a delta and returns the series start time and new total.
+func (c *cumulator) add(key string, delta int64, ts string) (string, int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.get(key, ts)
+	s.total += delta
+	return s.start, s.total
+}
+
+// start records the latest value of a total the agent reports itself and
+// returns the series start time. A monotonic total going down means the
+// process restarted, so its series starts over at ts.
+func (c *cumulator) start(key string, value float64, monotonic bool, ts string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.get(key, ts)
+	if monotonic && value < s.last {
+		s.start = ts
+	}
+	s.last = value
+	return s.start
+}
+
+// get returns the series key, created at ts if new, and drops series not
+// seen for cumulativeSeriesTTL. c.mu must be held.
+func (c *cumulator) get(key, ts string) *cumulativeSeries {
+	now := time.Now()
+	if now.Sub(c.lastSweep) > time.Minute {
+		for k, s := range c.series {
+			if now.Sub(s.seen) > cumulativeSeriesTTL {
+				delete(c.series, k)
+			}
+		}
+		c.lastSweep = now
+	}
+	s := c.series[key]
+	if s == nil {
+		s = &cumulativeSeries{start: ts}
+		c.series[key] = s
+	}
+	s.seen = now
+	return s
+}
@@ -0,0 +1,208 @@
+package converter
+
+import (
+	"strings"
+	"testing"
+
+	"skywalking_transformer/otel"
+	agentv3 "skywalking_transformer/skywalking/v3"
+)
+
+// metricsOf indexes a payload's metrics by name.
+func metricsOf(t *testing.T, payload otel.OTelPayload) map[string]otel.Metric {
+	t.Helper()
+	if len(payload.ResourceMetrics) != 1 || len(payload.ResourceMetrics[0].ScopeMetrics) != 1 {
+		t.Fatalf("payload has %d resource metrics, want 1", len(payload.ResourceMetrics))
+	}
+	out := map[string]otel.Metric{}
+	for _, m := range payload.ResourceMetrics[0].ScopeMetrics[0].Metrics {
+		out[m.Name] = m
+	}
+	return out
+}
+
+// numberPoints returns the data points of a gauge or sum.
+func numberPoints(m otel.Metric) []otel.NumberDataPoint {
+	if m.Gauge != nil {
+		return m.Gauge.DataPoints
+	}
+	if m.Sum != nil {
+		return m.Sum.DataPoints
+	}
+	return nil
+}
+
+// pointWith returns the metric's point whose attribute key has value, or the
+// only point when key is empty.
+func pointWith(t *testing.T, m otel.Metric, key, value string) otel.NumberDataPoint {
+	t.Helper()
+	for _, dp := range numberPoints(m) {
+		if key == "" {
+			return dp
+		}
+		for _, a := range dp.Attributes {
+			if a.Key == key && a.Value.StringValue == value {
+				return dp
+			}
+		}
+	}
+	t.Fatalf("%s has no point with %s=%q", m.Name, key, value)
+	return otel.NumberDataPoint{}
+}
+
+func intValue(t *testing.T, dp otel.NumberDataPoint) int64 {
+	t.Helper()
+	if dp.AsInt == nil {
+		t.Fatal("point has no int value")
+	}
+	return *dp.AsInt
+}
+
+func TestCLRMetricsToOtel(t *testing.T) {
+	report := func(gen0 int64) *agentv3.CLRMetricCollection {
+		return &agentv3.CLRMetricCollection{
+			Service:         "clr-test",
+			ServiceInstance: "inst-1",
+			Metrics: []*agentv3.CLRMetric{{
+				Time: 1700000000000,
+				Cpu:  &agentv3.CPU{UsagePercent: 25},
+				Gc:   &agentv3.ClrGC{Gen0CollectCount: gen0, Gen1CollectCount: 1, HeapMemory: 4096},
+				Thread: &agentv3.ClrThread{
+					AvailableWorkerThreads:         10,
+					AvailableCompletionPortThreads: 5,
+					MaxWorkerThreads:               100,
+					MaxCompletionPortThreads:       50,
+				},
+			}},
+		}
+	}
+	CLRMetricsToOtel(report(2))
+	payload := CLRMetricsToOtel(report(3))
+	byName := metricsOf(t, payload)
+
+	for name := range byName {
+		if !strings.HasPrefix(name, "process.runtime.dotnet.") {
+			t.Errorf("metric %s outside process.runtime.dotnet.*", name)
+		}
+	}
+	if res := payload.ResourceMetrics[0].Resource; res.StringAttribute("service.name") != "clr-test" {
+		t.Errorf("service.name = %q, want clr-test", res.StringAttribute("service.name"))
+	}
+
+	cpu := pointWith(t, byName["process.runtime.dotnet.cpu.utilization"], "", "")
+	if cpu.AsDouble == nil || *cpu.AsDouble != 0.25 {
+		t.Errorf("cpu.utilization = %v, want 0.25", cpu.AsDouble)
+	}
+
+	gc := byName["process.runtime.dotnet.gc.collections.count"]
+	if gc.Sum == nil || !gc.Sum.IsMonotonic {
+		t.Fatal("gc.collections.count is not a monotonic sum")
+	}
+	for _, tt := range []struct {
+		generation string
+		want       int64
+	}{
+		{"gen0", 5}, // summed over both reports
+		{"gen1", 2},
+		{"gen2", 0},
+	} {
+		dp := pointWith(t, gc, "generation", tt.generation)
+		if got := intValue(t, dp); got != tt.want {
+			t.Errorf("gc.collections.count{%s} = %d, want %d", tt.generation, got, tt.want)
+		}
+		if dp.StartTimeUnixNano == "" {
+			t.Errorf("gc.collections.count{%s} has no start time", tt.generation)
+		}
+	}
+
+	if got := intValue(t, pointWith(t, byName["process.runtime.dotnet.gc.objects.size"], "", "")); got != 4096 {
+		t.Errorf("gc.objects.size = %d, want 4096", got)
+	}
+
+	for _, tt := range []struct {
+		metric, pool string
+		want         int64
+	}{
+		{"process.runtime.dotnet.thread_pool.threads.available", "worker", 10},
+		{"process.runtime.dotnet.thread_pool.threads.available", "completion_port", 5},
+		{"process.runtime.dotnet.thread_pool.threads.max", "worker", 100},
+		{"process.runtime.dotnet.thread_pool.threads.max", "completion_port", 50},
+	} {
+		if got := intValue(t, pointWith(t, byName[tt.metric], "type", tt.pool)); got != tt.want {
+			t.Errorf("%s{%s} = %d, want %d", tt.metric, tt.pool, got, tt.want)
+		}
+	}
+}
+
+func TestJVMMetricsToOtel(t *testing.T) {
+	report := func(ms, loaded, gcCount, gcTime int64) otel.OTelPayload {
+		return JVMMetricsToOtel(&agentv3.JVMMetricCollection{
+			Service:         "jvm-test",
+			ServiceInstance: "inst-1",
+			Metrics: []*agentv3.JVMMetric{{
+				Time: ms,
+				Cpu:  &agentv3.CPU{UsagePercent: 50},
+				MemoryPool: []*agentv3.MemoryPool{
+					{Type: agentv3.PoolType_NEWGEN_USAGE, Init: 16, Max: -1, Used: 512, Committed: 1024},
+				},
+				Gc:     []*agentv3.GC{{Phase: agentv3.GCPhase_NEW, Count: gcCount, Time: gcTime}},
+				Thread: &agentv3.Thread{RunnableStateThreadCount: 7, DaemonCount: 3, PeakCount: 12},
+				Clazz:  &agentv3.Class{LoadedClassCount: loaded, TotalLoadedClassCount: loaded},
+			}},
+		})
+	}
+	first, second, restart := int64(1700000000000), int64(1700000010000), int64(1700000020000)
+	report(first, 100, 2, 30)
+	byName := metricsOf(t, report(second, 120, 1, 20))
+
+	for name, m := range byName {
+		if m.Histogram != nil {
+			t.Errorf("%s is a histogram; agents report no distributions", name)
+		}
+		if m.Sum == nil {
+			continue
+		}
+		for _, dp := range m.Sum.DataPoints {
+			if dp.StartTimeUnixNano != metricTime(first) {
+				t.Errorf("%s starts at %q, want the first report %q", name, dp.StartTimeUnixNano, metricTime(first))
+			}
+		}
+	}
+
+	cpu := pointWith(t, byName["jvm.cpu.recent_utilization"], "", "")
+	if cpu.AsDouble == nil || *cpu.AsDouble != 0.5 {
+		t.Errorf("jvm.cpu.recent_utilization = %v, want 0.5", cpu.AsDouble)
+	}
+	used := pointWith(t, byName["jvm.memory.used"], "jvm.memory.pool.name", "Eden Space")
+	if got := intValue(t, used); got != 512 {
+		t.Errorf("jvm.memory.used{Eden Space} = %d, want 512", got)
+	}
+	if used.Attributes[0].Value.StringValue != "heap" {
+		t.Errorf("Eden Space has jvm.memory.type %q, want heap", used.Attributes[0].Value.StringValue)
+	}
+	if _, ok := byName["jvm.memory.limit"]; ok {
+		t.Error("jvm.memory.limit reported for a pool without a maximum")
+	}
+	if got := intValue(t, pointWith(t, byName["jvm.thread.count"], "jvm.thread.state", "runnable")); got != 7 {
+		t.Errorf("jvm.thread.count{runnable} = %d, want 7", got)
+	}
+
+	gcCount := pointWith(t, byName["codexray.jvm.gc.count"], "jvm.gc.action", "end of minor GC")
+	if got := intValue(t, gcCount); got != 3 {
+		t.Errorf("codexray.jvm.gc.count = %d, want 3 summed over both reports", got)
+	}
+	gcTime := pointWith(t, byName["codexray.jvm.gc.time"], "jvm.gc.action", "end of minor GC")
+	if gcTime.AsDouble == nil || *gcTime.AsDouble != 0.05 {
+		t.Errorf("codexray.jvm.gc.time = %v, want 0.05 s", gcTime.AsDouble)
+	}
+
+	// a lower total loaded count means the JVM restarted
+	byName = metricsOf(t, report(restart, 50, 1, 10))
+	loaded := pointWith(t, byName["jvm.class.loaded"], "", "")
+	if loaded.StartTimeUnixNano != metricTime(restart) {
+		t.Errorf("jvm.class.loaded starts at %q after a restart, want %q", loaded.StartTimeUnixNano, metricTime(restart))
+	}
+	if count := pointWith(t, byName["jvm.class.count"], "", ""); count.StartTimeUnixNano != metricTime(first) {
+		t.Errorf("jvm.class.count, an up-down counter, restarted at %q", count.StartTimeUnixNano)
+	}
+}
@@ -0,0 +1,173 @@
+package converter
+
+import (
+	"sort"
+	"strings"
+
+	"skywalking_transformer/otel"
+	"skywalking_transformer/skywalking"
+)
+
+// Resource attribute keys set besides service.name and service.instance.id.
+const (
+	TeamIDAttribute      = "codexray.team.id"
+	ServiceTypeAttribute = "codexray.service.type"
+	LanguageAttribute    = "telemetry.sdk.language"
+	LayerAttribute       = "skywalking.layer"
+)
+
+// staticResource is appended to every resource, see SetResourceAttributes.
+var staticResource []otel.Attribute
+
+// instanceLookup returns attributes known about a service instance, see
+// SetInstanceLookup.
+var instanceLookup func(service, instance string) []otel.Attribute
+
+// SetInstanceLookup installs the source of per-instance resource attributes
+// (host, OS, process), keyed by service name and instance. Set it at startup.
+func SetInstanceLookup(fn func(service, instance string) []otel.Attribute) {
+	instanceLookup = fn
+}
+
+// SetResourceAttributes sets attributes added to every converted resource,
+// e.g. deployment.environment. They never replace the converted ones. Not
+// safe to call concurrently with conversions; set it at startup.
+func SetResourceAttributes(attrs map[string]string) {
+	staticResource = staticResource[:0]
+	for k, v := range attrs {
+		staticResource = append(staticResource, otel.Attribute{Key: k, Value: otel.AttributeVal{StringValue: v}})
+	}
+	sort.Slice(staticResource, func(i, j int) bool { return staticResource[i].Key < staticResource[j].Key })
+}
+
+// languageByType maps service types agents report to telemetry.sdk.language
+// values.
+var languageByType = map[string]string{
+	"java":   "java",
+	"dotnet": "dotnet",
+	".net":   "dotnet",
+	"csharp": "dotnet",
+	"nodejs": "nodejs",
+	"node":   "nodejs",
+	"go":     "go",
+	"golang": "go",
+	"python": "python",
+	"php":    "php",
+	"ruby":   "ruby",
+	"rust":   "rust",
+	"cpp":    "cpp",
+	"c++":    "cpp",
+}
+
+// languageByComponent guesses the agent language from the SkyWalking
+// component ID, whose ranges are assigned per agent language in
+// component-libraries.yml.
+func languageByComponent(id int) string {
+	switch {
+	case id <= 0:
+		return ""
+	case id < 3000:
+		return "java"
+	case id < 4000:
+		return "dotnet"
+	case id < 5000:
+		return "nodejs"
+	case id < 6000:
+		return "go"
+	case id < 7000:
+		return "lua"
+	case id < 8000:
+		return "python"
+	case id < 9000:
+		return "php"
+	case id < 10000:
+		return "cpp"
+	case id < 11000:
+		return "webjs"
+	case id < 12000:
+		return "rust"
+	default:
+		return ""
+	}
+}
+
+// NormalizeLanguage maps a language or service type name to its
+// telemetry.sdk.language value; unknown names are only lowercased.
+func NormalizeLanguage(name string) string {
+	n := strings.ToLower(strings.TrimSpace(name))
+	if lang, ok := languageByType[n]; ok {
+		return lang
+	}
+	return n
+}
+
+// guessLanguage prefers the service type and falls back to the components.
+func guessLanguage(svc Service, spans []skywalking.Span) string {
+	if lang, ok := languageByType[strings.ToLower(svc.Type)]; ok {
+		return lang
+	}
+	for i := range spans {
+		if lang := languageByComponent(spans[i].ComponentId); lang != "" {
+			return lang
+		}
+	}
+	return ""
+}
+
+// segmentLayer is the layer of the segment's entry span (how the service was
+// called), else of the first span that has one.
+func segmentLayer(spans []skywalking.Span) string {
+	first := ""
+	for i := range spans {
+		if spans[i].SpanLayer == "" {
+			continue
+		}
+		if spans[i].SpanType == "Entry" || spans[i].SpanType == "0" {
+			return spans[i].SpanLayer
+		}
+		if first == "" {
+			first = spans[i].SpanLayer
+		}
+	}
+	return first
+}
+
+func resourceAttributes(sw *skywalking.TraceSegment, svc Service) []otel.Attribute {
+	return buildResource(svc, sw.ServiceInstance, guessLanguage(svc, sw.Spans), segmentLayer(sw.Spans))
+}
+
+// buildResource assembles the resource of a service instance. language and
+// layer only apply when the agent did not report them itself.
+func buildResource(svc Service, instance, language, layer string) []otel.Attribute {
+	attrs := []otel.Attribute{
+		{Key: "service.name", Value: otel.AttributeVal{StringValue: svc.Name}},
+		{Key: "service.instance.id", Value: otel.AttributeVal{StringValue: instance}},
+	}
+	if instanceLookup != nil {
+		// reported by the agent itself, so more reliable than the guesses below
+		attrs = append(attrs, instanceLookup(svc.Name, instance)...)
+	}
+	has := func(key string) bool {
+		for _, a := range attrs {
+			if a.Key == key {
+				return true
+			}
+		}
+		return false
+	}
+	add := func(key, value string) {
+		if value != "" && !has(key) {
+			attrs = append(attrs, otel.Attribute{Key: key, Value: otel.AttributeVal{StringValue: value}})
+		}
+	}
+	add(TeamIDAttribute, svc.TeamID)
+	add(ServiceTypeAttribute, svc.Type)
+	add(LanguageAttribute, language)
+	add(LayerAttribute, layer)
+	for _, a := range staticResource {
+		if !has(a.Key) {
+			attrs = append(attrs, a)
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/gin-gonic/gin"
+
+	"skywalking_transformer/metrics"
+	"skywalking_transformer/otel"
+)
+
+// ----------- Dead letter -----------
+
+// deadLetterBatch stores a batch that exhausted its retries and reports
+// whether it was kept.
+func (p *pipeline) deadLetterBatch(payload otel.OTelPayload) bool {
+	what := describe(payload)
+	if p.deadLetter == nil {
+		log.Printf("[%s] dropping batch of %s", p.name, what)
+		metrics.Dropped.WithLabelValues(p.name, "export_failed").Inc()
+		return false
+	}
+	line, err := json.Marshal(payload)
+	if err == nil {
+		err = p.deadLetter.Write(line)
+	}
+	if err != nil {
+		log.Printf("[%s] dead letter write failed, dropping batch of %s: %v", p.name, what, err)
+		metrics.Dropped.WithLabelValues(p.name, "export_failed").Inc()
+		return false
+	}
+	log.Printf("[%s] dead-lettered batch of %s", p.name, what)
+	metrics.DeadLettered.WithLabelValues(p.name).Inc()
+	return true
+}
+
+var errReplayQueueFull = errors.New("queue full")
+
+// replayDeadLetter re-enqueues the pipeline's dead-lettered batches into its
+// own queue only; the other exporters already have them.
+func (p *pipeline) replayDeadLetter() (int, error) {
+	return p.deadLetter.Replay(func(line []byte) error {
+		var payload otel.OTelPayload
+		if err := json.Unmarshal(line, &payload); err != nil {
+			log.Printf("[%s] dead letter replay: skipping undecodable line: %v", p.name, err)
+			return nil
+		}
+		if !p.enqueue(payload, enqueueDeadline()) {
+			return errReplayQueueFull
+		}
+		return nil
+	})
+}
+
+// replayDeadLetterHandler re-enqueues all dead-lettered batches, or only
+// those of ?exporter=<name>. Whatever cannot be queued stays in the dead
+// letter directory.
+func replayDeadLetterHandler(c *gin.Context) {
+	if deadLetterDir == "" {
+		c.JSON(404, gin.H{"error": "dead letter not enabled (CODEXRAY_DEAD_LETTER_DIR)"})
+		return
+	}
+	only := c.Query("exporter")
+	replayed := gin.H{}
+	var failed []string
+	for _, p := range pipelines {
+		if only != "" && p.name != only {
+			continue
+		}
+		n, err := p.replayDeadLetter()
+		replayed[p.name] = n
+		if err != nil {
+			log.Printf("[%s] dead letter replay stopped after %d batches: %v", p.name, n, err)
+			failed = append(failed, fmt.Sprintf("%s: %v", p.name, err))
+			continue
+		}
+		log.Printf("[%s] dead letter replay queued %d batches", p.name, n)
+	}
+	if len(replayed) == 0 {
+		c.JSON(404, gin.H{"error": fmt.Sprintf("unknown exporter %q", only)})
+		return
+	}
+	if len(failed) > 0 {
+		c.JSON(503, gin.H{"replayed": replayed, "errors": failed})
+		return
+	}
+	c.JSON(200, gin.H{"replayed": replayed})
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+
+	"skywalking_transformer/metrics"
+	"skywalking_transformer/otel"
+	"skywalking_transformer/queue"
+)
+
+// ----------- Disk queue -----------
+
+// With CODEXRAY_QUEUE_DIR set, each pipeline persists converted payloads
+// between the handlers and its batcher. Records are acknowledged once their
+// batch was exported.
+
+func (p *pipeline) appendToDiskQueue(payload otel.OTelPayload) bool {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("[%s] disk queue encode error: %v", p.name, err)
+		metrics.ConversionFailures.WithLabelValues("disk_queue", "encode").Inc()
+		return false
+	}
+	if err := p.diskQueue.Append(data); err != nil {
+		log.Printf("[%s] disk queue append error: %v", p.name, err)
+		return false
+	}
+	return true
+}
+
+// runQueueReader feeds disk queue records to the batcher. It owns jobCh while
+// the disk queue is enabled and closes it on shutdown.
+func (p *pipeline) runQueueReader(ctx context.Context) {
+	defer close(p.jobCh)
+	for {
+		rec, err := p.diskQueue.Next(ctx)
+		if errors.Is(err, context.Canceled) || errors.Is(err, queue.ErrClosed) {
+			return
+		}
+		if err != nil {
+			log.Printf("[%s] disk queue read error: %v", p.name, err)
+			continue
+		}
+		var payload otel.OTelPayload
+		if err := json.Unmarshal(rec.Data, &payload); err != nil {
+			// undecodable, acknowledge so it does not block the segment forever
+			log.Printf("[%s] disk queue decode error, skipping record: %v", p.name, err)
+			metrics.ConversionFailures.WithLabelValues("disk_queue", "decode").Inc()
+			p.diskQueue.Ack(rec.ID)
+			continue
+		}
+		id := rec.ID
+		select {
+		case p.jobCh <- job{payload: payload, tenant: p.tenant(payload), ack: func() { p.diskQueue.Ack(id) }}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
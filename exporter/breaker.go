@@ -0,0 +1,191 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"skywalking_transformer/otel"
+)
+
+// ErrCircuitOpen is returned instead of calling the collector while the
+// circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// BreakerState is the state of a Breaker.
+type BreakerState int
+
+const (
+	BreakerClosed BreakerState = iota
+	BreakerOpen
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// BreakerConfig tunes a Breaker.
+type BreakerConfig struct {
+	FailureThreshold int           // consecutive failures that open the circuit
+	OpenTimeout      time.Duration // how long to stay open before probing
+	HalfOpenProbes   int           // successful probes needed to close again
+}
+
+// Breaker is a closed/open/half-open circuit breaker. Once the collector
+// failed FailureThreshold times in a row, calls fail fast with ErrCircuitOpen
+// for OpenTimeout; then up to HalfOpenProbes probe requests go through, and
+// the circuit closes if they all succeed or opens again on the first failure.
+// Only transient failures count: a rejected payload says nothing about the
+// collector's health.
+type Breaker struct {
+	cfg BreakerConfig
+
+	mu        sync.Mutex
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	inFlight  int // probes running in half-open
+	successes int // probes succeeded in half-open
+	gen       uint64
+}
+
+func NewBreaker(cfg BreakerConfig) *Breaker {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = 1
+	}
+	if cfg.HalfOpenProbes <= 0 {
+		cfg.HalfOpenProbes = 1
+	}
+	return &Breaker{cfg: cfg}
+}
+
+// State returns the current state, moving from open to half-open once the
+// open timeout has passed.
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance()
+	return b.state
+}
+
+// HalfOpenIn returns how long the circuit stays open before it lets probes
+// through, or 0 when it is not open.
+func (b *Breaker) HalfOpenIn() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance()
+	if b.state != BreakerOpen {
+		return 0
+	}
+	return b.cfg.OpenTimeout - time.Since(b.openedAt)
+}
+
+// allow reports whether a call may go out now; every allowed call must be
+// followed by record with the returned generation.
+func (b *Breaker) allow() (uint64, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance()
+	switch b.state {
+	case BreakerOpen:
+		return 0, false
+	case BreakerHalfOpen:
+		if b.inFlight+b.successes >= b.cfg.HalfOpenProbes {
+			return 0, false
+		}
+		b.inFlight++
+	}
+	return b.gen, true
+}
+
+// record reports the outcome of a call. Outcomes of calls started before the
+// last state change are ignored.
+func (b *Breaker) record(gen uint64, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if gen != b.gen {
+		return
+	}
+	failed := err != nil && Retryable(err)
+	switch b.state {
+	case BreakerHalfOpen:
+		b.inFlight--
+		if failed {
+			b.setState(BreakerOpen)
+			return
+		}
+		b.successes++
+		if b.successes >= b.cfg.HalfOpenProbes {
+			b.setState(BreakerClosed)
+		}
+	case BreakerClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.cfg.FailureThreshold {
+			b.setState(BreakerOpen)
+		}
+	}
+}
+
+func (b *Breaker) advance() {
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= b.cfg.OpenTimeout {
+		b.setState(BreakerHalfOpen)
+	}
+}
+
+func (b *Breaker) setState(s BreakerState) {
+	log.Printf("Circuit breaker %s -> %s", b.state, s)
+	b.state = s
+	b.gen++
+	b.failures, b.inFlight, b.successes = 0, 0, 0
+	if s == BreakerOpen {
+		b.openedAt = time.Now()
+	}
+}
+
+// breakerExporter guards an Exporter with a Breaker.
+type breakerExporter struct {
+	Exporter
+	b *Breaker
+}
+
+// WithBreaker returns an Exporter that fails fast with ErrCircuitOpen while b
+// is open.
+func WithBreaker(e Exporter, b *Breaker) Exporter {
+	return &breakerExporter{Exporter: e, b: b}
+}
+
+func (e *breakerExporter) ExportTraces(ctx context.Context, p otel.OTelPayload) error {
+	return e.call(func() error { return e.Exporter.ExportTraces(ctx, p) })
+}
+
+func (e *breakerExporter) ExportLogs(ctx context.Context, p otel.OTelPayload) error {
+	return e.call(func() error { return e.Exporter.ExportLogs(ctx, p) })
+}
+
+func (e *breakerExporter) ExportMetrics(ctx context.Context, p otel.OTelPayload) error {
+	return e.call(func() error { return e.Exporter.ExportMetrics(ctx, p) })
+}
+
+func (e *breakerExporter) call(export func() error) error {
+	gen, ok := e.b.allow()
+	if !ok {
+		return ErrCircuitOpen
+	}
+	err := export()
+	e.b.record(gen, err)
+	return err
+}
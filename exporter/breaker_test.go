@@ -0,0 +1,110 @@
+package exporter
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var errTransient = errors.New("connection refused")
+
+// fail runs n failed calls through b.
+func fail(t *testing.T, b *Breaker, n int, err error) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		gen, ok := b.allow()
+		if !ok {
+			t.Fatalf("call %d not allowed", i+1)
+		}
+		b.record(gen, err)
+	}
+}
+
+func TestBreakerOpensAfterThreshold(t *testing.T) {
+	b := NewBreaker(BreakerConfig{FailureThreshold: 3, OpenTimeout: time.Hour})
+	fail(t, b, 2, errTransient)
+	if s := b.State(); s != BreakerClosed {
+		t.Fatalf("state after 2 failures = %s, want closed", s)
+	}
+	fail(t, b, 1, errTransient)
+	if s := b.State(); s != BreakerOpen {
+		t.Fatalf("state after 3 failures = %s, want open", s)
+	}
+	if _, ok := b.allow(); ok {
+		t.Error("open breaker allowed a call")
+	}
+	if d := b.HalfOpenIn(); d <= 0 || d > time.Hour {
+		t.Errorf("HalfOpenIn = %s, want within the open timeout", d)
+	}
+}
+
+func TestBreakerCountsOnlyConsecutiveTransientFailures(t *testing.T) {
+	b := NewBreaker(BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Hour})
+	for _, err := range []error{
+		errTransient,
+		nil, // resets the count
+		errTransient,
+		&StatusError{StatusCode: http.StatusBadRequest}, // the collector is fine
+		&PermanentError{Err: errors.New("encode")},
+	} {
+		fail(t, b, 1, err)
+	}
+	if s := b.State(); s != BreakerClosed {
+		t.Errorf("state = %s, want closed", s)
+	}
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	tests := []struct {
+		name  string
+		probe error
+		want  BreakerState
+	}{
+		{"probe succeeds", nil, BreakerClosed},
+		{"probe fails", errTransient, BreakerOpen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBreaker(BreakerConfig{FailureThreshold: 1, OpenTimeout: 10 * time.Millisecond, HalfOpenProbes: 1})
+			fail(t, b, 1, errTransient)
+			time.Sleep(20 * time.Millisecond)
+			if s := b.State(); s != BreakerHalfOpen {
+				t.Fatalf("state after open timeout = %s, want half-open", s)
+			}
+			if d := b.HalfOpenIn(); d != 0 {
+				t.Errorf("HalfOpenIn = %s while half-open, want 0", d)
+			}
+			gen, ok := b.allow()
+			if !ok {
+				t.Fatal("half-open breaker refused the probe")
+			}
+			if _, ok := b.allow(); ok {
+				t.Error("half-open breaker allowed more calls than probes")
+			}
+			b.record(gen, tt.probe)
+			if s := b.State(); s != tt.want {
+				t.Errorf("state after probe = %s, want %s", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestBreakerIgnoresOutcomesOfEarlierGenerations(t *testing.T) {
+	b := NewBreaker(BreakerConfig{FailureThreshold: 1, OpenTimeout: 10 * time.Millisecond, HalfOpenProbes: 1})
+	stale, _ := b.allow() // started while closed, finishes after the state changed
+	fail(t, b, 1, errTransient)
+	time.Sleep(20 * time.Millisecond)
+	probe, ok := b.allow()
+	if !ok {
+		t.Fatal("half-open breaker refused the probe")
+	}
+	b.record(stale, nil)
+	if s := b.State(); s != BreakerHalfOpen {
+		t.Fatalf("state after stale success = %s, want half-open", s)
+	}
+	b.record(probe, nil)
+	if s := b.State(); s != BreakerClosed {
+		t.Errorf("state after probe = %s, want closed", s)
+	}
+}
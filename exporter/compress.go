@@ -0,0 +1,100 @@
+package exporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression algorithms for outbound requests.
+const (
+	CompressionNone = "none"
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+// Bytes before and after compression across all exporters, for the
+// compression ratio.
+var (
+	rawBytes        atomic.Uint64
+	compressedBytes atomic.Uint64
+)
+
+// CompressionRatio returns uncompressed/compressed bytes sent so far, or 0
+// before anything was compressed.
+func CompressionRatio() float64 {
+	c := compressedBytes.Load()
+	if c == 0 {
+		return 0
+	}
+	return float64(rawBytes.Load()) / float64(c)
+}
+
+// compressor encodes request bodies; it is safe for concurrent use.
+type compressor struct {
+	encoding string
+	gzipPool sync.Pool
+	zstdEnc  *zstd.Encoder
+}
+
+// newCompressor returns nil for no compression. level 0 picks the algorithm's
+// default; otherwise it is the gzip level (1-9) or zstd level (1-22, mapped
+// onto the closest level the encoder implements).
+func newCompressor(algorithm string, level int) (*compressor, error) {
+	switch algorithm {
+	case "", CompressionNone:
+		return nil, nil
+	case CompressionGzip:
+		if level == 0 {
+			level = gzip.DefaultCompression
+		}
+		if _, err := gzip.NewWriterLevel(nil, level); err != nil {
+			return nil, err
+		}
+		c := &compressor{encoding: CompressionGzip}
+		c.gzipPool.New = func() any {
+			w, _ := gzip.NewWriterLevel(nil, level)
+			return w
+		}
+		return c, nil
+	case CompressionZstd:
+		encLevel := zstd.SpeedDefault
+		if level != 0 {
+			encLevel = zstd.EncoderLevelFromZstd(level)
+		}
+		enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(encLevel))
+		if err != nil {
+			return nil, err
+		}
+		return &compressor{encoding: CompressionZstd, zstdEnc: enc}, nil
+	default:
+		return nil, fmt.Errorf("unknown compression %q", algorithm)
+	}
+}
+
+func (c *compressor) compress(b []byte) ([]byte, error) {
+	var out []byte
+	switch c.encoding {
+	case CompressionZstd:
+		out = c.zstdEnc.EncodeAll(b, make([]byte, 0, len(b)/4))
+	default:
+		var buf bytes.Buffer
+		w := c.gzipPool.Get().(*gzip.Writer)
+		defer c.gzipPool.Put(w)
+		w.Reset(&buf)
+		if _, err := w.Write(b); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		out = buf.Bytes()
+	}
+	rawBytes.Add(uint64(len(b)))
+	compressedBytes.Add(uint64(len(out)))
+	return out, nil
+}
@@ -0,0 +1,155 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatusError is returned when the collector answered with a non-2xx status.
+type StatusError struct {
+	StatusCode int
+	Status     string
+	RetryAfter time.Duration // from the Retry-After header, 0 if absent
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("otel backend returned status: %s", e.Status)
+}
+
+func newStatusError(resp *http.Response) *StatusError {
+	return &StatusError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+}
+
+// PermanentError wraps a failure before anything was sent, such as encoding
+// the payload; retrying cannot fix it.
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return "encode export: " + e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// parseRetryAfter accepts both forms of the header: delay-seconds and an
+// HTTP date.
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// Retryable reports whether an export error is transient, following the OTLP
+// spec: network errors, HTTP 429/502/503/504 and the equivalent gRPC codes are
+// retried, RESOURCE_EXHAUSTED only when the server sent RetryInfo; anything
+// else (e.g. 400, a malformed payload, a cancelled call) would fail again.
+func Retryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrCircuitOpen) {
+		return true
+	}
+	var pe *PermanentError
+	if errors.As(err, &pe) {
+		return false
+	}
+	var se *StatusError
+	if errors.As(err, &se) {
+		switch se.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway,
+			http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.DeadlineExceeded, codes.Aborted, codes.OutOfRange,
+			codes.Unavailable, codes.DataLoss:
+			return true
+		case codes.ResourceExhausted:
+			_, ok := retryInfo(st)
+			return ok
+		}
+		return false
+	}
+	// transport level: connection refused, timeout, reset...
+	return true
+}
+
+// RetryAfter returns the delay the collector asked for (Retry-After header or
+// gRPC RetryInfo), or 0.
+func RetryAfter(err error) time.Duration {
+	var se *StatusError
+	if errors.As(err, &se) {
+		return se.RetryAfter
+	}
+	if st, ok := status.FromError(err); ok {
+		delay, _ := retryInfo(st)
+		return delay
+	}
+	return 0
+}
+
+// retryInfo returns the delay of the RetryInfo detail of a gRPC status, if it
+// carries one.
+func retryInfo(st *status.Status) (time.Duration, bool) {
+	for _, d := range st.Details() {
+		if ri, ok := d.(*errdetails.RetryInfo); ok {
+			return ri.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+// Outcome labels the result of an export attempt for metrics: "ok", the HTTP
+// status code, the gRPC code name, "circuit_open", "encode_error", "timeout"
+// or "network_error".
+func Outcome(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	if errors.Is(err, ErrCircuitOpen) {
+		return "circuit_open"
+	}
+	var pe *PermanentError
+	if errors.As(err, &pe) {
+		return "encode_error"
+	}
+	var se *StatusError
+	if errors.As(err, &se) {
+		return strconv.Itoa(se.StatusCode)
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Code().String()
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "timeout"
+	}
+	return "network_error"
+}
@@ -0,0 +1,69 @@
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func withRetryInfo(code codes.Code, delay time.Duration) error {
+	st, err := status.New(code, "").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		panic(err)
+	}
+	return st.Err()
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"network", errors.New("connection refused"), true},
+		{"503", &StatusError{StatusCode: http.StatusServiceUnavailable}, true},
+		{"400", &StatusError{StatusCode: http.StatusBadRequest}, false},
+		{"grpc unavailable", status.Error(codes.Unavailable, ""), true},
+		{"grpc invalid argument", status.Error(codes.InvalidArgument, ""), false},
+		{"grpc canceled", status.Error(codes.Canceled, ""), false},
+		{"grpc resource exhausted", status.Error(codes.ResourceExhausted, ""), false},
+		{"grpc resource exhausted with retry info", withRetryInfo(codes.ResourceExhausted, time.Second), true},
+		{"encode", &PermanentError{Err: errors.New("json: unsupported value: NaN")}, false},
+		{"wrapped encode", fmt.Errorf("send: %w", &PermanentError{Err: errors.New("bad")}), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Retryable(tt.err); got != tt.want {
+				t.Errorf("Retryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want time.Duration
+	}{
+		{"http header", &StatusError{StatusCode: http.StatusTooManyRequests, RetryAfter: 3 * time.Second}, 3 * time.Second},
+		{"grpc retry info", withRetryInfo(codes.Unavailable, 2*time.Second), 2 * time.Second},
+		{"grpc without retry info", status.Error(codes.Unavailable, ""), 0},
+		{"network", errors.New("connection refused"), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RetryAfter(tt.err); got != tt.want {
+				t.Errorf("RetryAfter(%v) = %s, want %s", tt.err, got, tt.want)
+			}
+		})
+	}
+}
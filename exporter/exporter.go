@@ -0,0 +1,47 @@
+// Package exporter sends converted OTLP payloads to the collector.
+package exporter
+
+import (
+	"context"
+	"strings"
+
+	"skywalking_transformer/otel"
+)
+
+// Exporter delivers one batch of a signal to a collector. Implementations
+// are safe for concurrent use by the sender workers.
+type Exporter interface {
+	ExportTraces(ctx context.Context, p otel.OTelPayload) error
+	ExportLogs(ctx context.Context, p otel.OTelPayload) error
+	ExportMetrics(ctx context.Context, p otel.OTelPayload) error
+	Close() error
+}
+
+// SignalURL derives the OTLP/HTTP URL of another signal from a traces URL
+// ending in /v1/traces; other URLs are returned unchanged.
+func SignalURL(tracesURL, signal string) string {
+	if base, ok := strings.CutSuffix(tracesURL, "/v1/traces"); ok {
+		return base + "/v1/" + signal
+	}
+	return tracesURL
+}
+
+type headersKey struct{}
+
+// ContextWithHeaders attaches headers (gRPC metadata) for a single export
+// call, set on top of the exporter's static headers. Used for the tenant.
+func ContextWithHeaders(ctx context.Context, headers map[string]string) context.Context {
+	return context.WithValue(ctx, headersKey{}, headers)
+}
+
+func headersFromContext(ctx context.Context) map[string]string {
+	h, _ := ctx.Value(headersKey{}).(map[string]string)
+	return h
+}
+
+// Protocols accepted by New.
+const (
+	ProtocolHTTPJSON     = "http/json"
+	ProtocolHTTPProtobuf = "http/protobuf"
+	ProtocolGRPC         = "grpc"
+)
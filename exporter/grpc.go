@@ -0,0 +1,153 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	grpcgzip "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+
+	"skywalking_transformer/otel"
+)
+
+// GRPCConfig tunes the OTLP/gRPC connection.
+type GRPCConfig struct {
+	Insecure         bool
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+	// Compression is CompressionNone or CompressionGzip; gRPC has no standard
+	// zstd codec the collector would understand.
+	Compression      string
+	CompressionLevel int
+	Headers          map[string]string // sent as call metadata
+}
+
+// GRPCExporter calls the OTLP trace, logs and metrics Export RPCs over a
+// single long-lived connection shared by all sender workers; gRPC multiplexes
+// the calls over HTTP/2.
+type GRPCExporter struct {
+	conn    *grpc.ClientConn
+	client  coltracepb.TraceServiceClient
+	logs    collogspb.LogsServiceClient
+	metrics colmetricspb.MetricsServiceClient
+	md      metadata.MD
+}
+
+func NewGRPC(endpoint string, cfg GRPCConfig) (*GRPCExporter, error) {
+	creds := credentials.NewClientTLSFromCert(nil, "")
+	if cfg.Insecure {
+		creds = insecure.NewCredentials()
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                cfg.KeepaliveTime,
+			Timeout:             cfg.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+	}
+	switch cfg.Compression {
+	case "", CompressionNone:
+	case CompressionGzip:
+		if err := setGzipLevel(cfg.CompressionLevel); err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(grpcgzip.Name)))
+	default:
+		return nil, fmt.Errorf("compression %q is not supported over gRPC", cfg.Compression)
+	}
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+	e := &GRPCExporter{
+		conn:    conn,
+		client:  coltracepb.NewTraceServiceClient(conn),
+		logs:    collogspb.NewLogsServiceClient(conn),
+		metrics: colmetricspb.NewMetricsServiceClient(conn),
+	}
+	if len(cfg.Headers) > 0 {
+		e.md = metadata.New(cfg.Headers)
+	}
+	return e, nil
+}
+
+var (
+	gzipLevelMu  sync.Mutex
+	gzipLevelSet bool
+	gzipLevel    int
+)
+
+// setGzipLevel configures gRPC's gzip compressor, which is registered once per
+// process under the name the collector expects, so every gRPC exporter shares
+// its level. A second exporter asking for another level is an error rather
+// than silently changing the first one's.
+func setGzipLevel(level int) error {
+	gzipLevelMu.Lock()
+	defer gzipLevelMu.Unlock()
+	if gzipLevelSet {
+		if level != gzipLevel {
+			return fmt.Errorf("gzip level %s conflicts with level %s of another gRPC exporter; gRPC exporters share one level",
+				gzipLevelName(level), gzipLevelName(gzipLevel))
+		}
+		return nil
+	}
+	if level != 0 {
+		if err := grpcgzip.SetLevel(level); err != nil {
+			return err
+		}
+	}
+	gzipLevelSet, gzipLevel = true, level
+	return nil
+}
+
+func gzipLevelName(level int) string {
+	if level == 0 {
+		return "default"
+	}
+	return strconv.Itoa(level)
+}
+
+func (e *GRPCExporter) ExportTraces(ctx context.Context, p otel.OTelPayload) error {
+	_, err := e.client.Export(e.outgoing(ctx), p.ToProto())
+	return err
+}
+
+func (e *GRPCExporter) ExportLogs(ctx context.Context, p otel.OTelPayload) error {
+	_, err := e.logs.Export(e.outgoing(ctx), p.LogsToProto())
+	return err
+}
+
+func (e *GRPCExporter) ExportMetrics(ctx context.Context, p otel.OTelPayload) error {
+	_, err := e.metrics.Export(e.outgoing(ctx), p.MetricsToProto())
+	return err
+}
+
+// outgoing attaches the static and per-call headers as metadata.
+func (e *GRPCExporter) outgoing(ctx context.Context) context.Context {
+	md := e.md
+	if h := headersFromContext(ctx); len(h) > 0 {
+		md = md.Copy()
+		for k, v := range h {
+			md.Set(k, v)
+		}
+	}
+	if md != nil {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+	return ctx
+}
+
+func (e *GRPCExporter) Close() error {
+	return e.conn.Close()
+}
@@ -0,0 +1,134 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"google.golang.org/protobuf/proto"
+
+	"skywalking_transformer/otel"
+)
+
+// HTTPExporter posts OTLP/JSON or OTLP/protobuf to the collector's /v1/*
+// endpoints.
+type HTTPExporter struct {
+	url        string
+	logsURL    string
+	metricsURL string
+	client     *http.Client
+	protobuf   bool
+	compressor *compressor
+	headers    map[string]string
+}
+
+// HTTPConfig selects the body encoding and compression.
+type HTTPConfig struct {
+	Protocol         string            // ProtocolHTTPJSON or ProtocolHTTPProtobuf
+	Compression      string            // CompressionNone, CompressionGzip or CompressionZstd
+	CompressionLevel int               // 0 for the algorithm's default
+	Headers          map[string]string // added to every request, e.g. auth or tenant
+	LogsURL          string            // defaults to the traces URL with /v1/logs
+	MetricsURL       string            // defaults to the traces URL with /v1/metrics
+}
+
+func NewHTTP(url string, client *http.Client, cfg HTTPConfig) (*HTTPExporter, error) {
+	c, err := newCompressor(cfg.Compression, cfg.CompressionLevel)
+	if err != nil {
+		return nil, err
+	}
+	logsURL := cfg.LogsURL
+	if logsURL == "" {
+		logsURL = SignalURL(url, "logs")
+	}
+	metricsURL := cfg.MetricsURL
+	if metricsURL == "" {
+		metricsURL = SignalURL(url, "metrics")
+	}
+	return &HTTPExporter{
+		url:        url,
+		logsURL:    logsURL,
+		metricsURL: metricsURL,
+		client:     client,
+		protobuf:   cfg.Protocol == ProtocolHTTPProtobuf,
+		compressor: c,
+		headers:    cfg.Headers,
+	}, nil
+}
+
+func (e *HTTPExporter) ExportTraces(ctx context.Context, p otel.OTelPayload) error {
+	if e.protobuf {
+		return e.post(ctx, e.url, p.ToProto())
+	}
+	return e.post(ctx, e.url, p)
+}
+
+func (e *HTTPExporter) ExportLogs(ctx context.Context, p otel.OTelPayload) error {
+	if e.protobuf {
+		return e.post(ctx, e.logsURL, p.LogsToProto())
+	}
+	return e.post(ctx, e.logsURL, p)
+}
+
+func (e *HTTPExporter) ExportMetrics(ctx context.Context, p otel.OTelPayload) error {
+	if e.protobuf {
+		return e.post(ctx, e.metricsURL, p.MetricsToProto())
+	}
+	return e.post(ctx, e.metricsURL, p)
+}
+
+// post sends body, a proto.Message for http/protobuf or the JSON model.
+func (e *HTTPExporter) post(ctx context.Context, url string, body any) error {
+	var (
+		payloadBytes []byte
+		contentType  string
+		err          error
+	)
+	if m, ok := body.(proto.Message); ok {
+		payloadBytes, err = proto.Marshal(m)
+		contentType = "application/x-protobuf"
+	} else {
+		payloadBytes, err = json.Marshal(body)
+		contentType = "application/json"
+	}
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+	if e.compressor != nil {
+		if payloadBytes, err = e.compressor.compress(payloadBytes); err != nil {
+			return &PermanentError{Err: err}
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payloadBytes))
+	if err != nil {
+		return &PermanentError{Err: err}
+	}
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+	for k, v := range headersFromContext(ctx) {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if e.compressor != nil {
+		req.Header.Set("Content-Encoding", e.compressor.encoding)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// drain so the connection goes back to the pool
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= 300 {
+		return newStatusError(resp)
+	}
+	return nil
+}
+
+func (e *HTTPExporter) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
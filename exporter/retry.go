@@ -0,0 +1,33 @@
+package exporter
+
+import (
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy describes how often and how long a failed export is retried.
+type RetryPolicy struct {
+	MaxAttempts    int           // including the first attempt
+	InitialBackoff time.Duration // delay before the first retry
+	MaxBackoff     time.Duration // cap for a single delay
+	Jitter         float64       // 0..1, fraction of the delay randomized
+	MaxElapsed     time.Duration // give up once this much time has passed
+}
+
+// Backoff returns the delay before retry n (1-based): InitialBackoff doubled
+// per retry, capped at MaxBackoff, then spread by ±Jitter so that workers
+// failing together do not retry together.
+func (p RetryPolicy) Backoff(n int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < n && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		delta := p.Jitter * float64(d)
+		d = time.Duration(float64(d) - delta + rand.Float64()*2*delta)
+	}
+	return d
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+
+	"skywalking_transformer/metrics"
+	"skywalking_transformer/skywalking"
+	agentv3 "skywalking_transformer/skywalking/v3"
+)
+
+// ----------- gRPC receiver (SkyWalking native protocol) -----------
+
+// grpcMaxRecvMsgSize is raised above the gRPC default of 4 MiB because agents
+// batch whole segment collections into one message in collectInSync.
+const grpcMaxRecvMsgSize = 16 << 20
+
+func newGRPCServer() *grpc.Server {
+	s := grpc.NewServer(
+		grpc.MaxRecvMsgSize(grpcMaxRecvMsgSize),
+		// agents ping idle streams; don't answer that with GOAWAY
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
+	)
+	agentv3.RegisterTraceSegmentReportServiceServer(s, &traceSegmentReportService{})
+	agentv3.RegisterManagementServiceServer(s, &managementService{})
+	agentv3.RegisterJVMMetricReportServiceServer(s, &jvmMetricService{})
+	agentv3.RegisterCLRMetricReportServiceServer(s, &clrMetricService{})
+	agentv3.RegisterLogReportServiceServer(s, &logReportService{})
+	agentv3.RegisterMeterReportServiceServer(s, &meterService{})
+	return s
+}
+
+// stopGRPCServer drains in-flight RPCs, but agents keep their collect streams
+// open indefinitely, so it falls back to a hard stop after timeout.
+func stopGRPCServer(s *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		s.Stop()
+	}
+}
+
+type traceSegmentReportService struct {
+	agentv3.UnimplementedTraceSegmentReportServiceServer
+}
+
+func (s *traceSegmentReportService) Collect(stream agentv3.TraceSegmentReportService_CollectServer) error {
+	for {
+		seg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&agentv3.Commands{})
+		}
+		if err != nil {
+			return err
+		}
+		metrics.SegmentsReceived.WithLabelValues("grpc:collect").Inc()
+		segment := skywalking.SegmentFromProto(seg)
+		if !enqueueSegment(&segment, enqueueDeadline()) && queueFullMode == queueFullReject {
+			// ends the stream; the agent reconnects and resends later
+			return status.Error(rejectCode(), "queue full, retry later")
+		}
+	}
+}
+
+func (s *traceSegmentReportService) CollectInSync(_ context.Context, in *agentv3.SegmentCollection) (*agentv3.Commands, error) {
+	metrics.SegmentsReceived.WithLabelValues("grpc:collectInSync").Add(float64(len(in.GetSegments())))
+	deadline := enqueueDeadline()
+	enqueued := 0
+	for _, seg := range in.GetSegments() {
+		segment := skywalking.SegmentFromProto(seg)
+		if enqueueSegment(&segment, deadline) {
+			enqueued++
+		}
+	}
+	if rejected := len(in.GetSegments()) - enqueued; rejected > 0 {
+		log.Printf("collectInSync: %d of %d segments not queued", rejected, len(in.GetSegments()))
+		if queueFullMode == queueFullReject {
+			return nil, status.Errorf(rejectCode(), "queue full: accepted %d, rejected %d segments", enqueued, rejected)
+		}
+	}
+	return &agentv3.Commands{}, nil
+}
+
+// rejectCode is the gRPC counterpart of CODEXRAY_QUEUE_REJECT_STATUS.
+func rejectCode() codes.Code {
+	if rejectStatus == http.StatusServiceUnavailable {
+		return codes.Unavailable
+	}
+	return codes.ResourceExhausted
+}
@@ -0,0 +1,290 @@
+// Package instance caches what agents report about their service instances
+// through the management protocol, so spans can carry it as resource
+// attributes.
+package instance
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"skywalking_transformer/converter"
+	"skywalking_transformer/otel"
+	"skywalking_transformer/skywalking"
+)
+
+// Properties are the instance properties the transformer understands.
+type Properties struct {
+	HostName     string   `json:"hostName,omitempty"`
+	OSName       string   `json:"osName,omitempty"`
+	OSVersion    string   `json:"osVersion,omitempty"`
+	ProcessID    int      `json:"processNo,omitempty"`
+	IPs          []string `json:"ipAddress,omitempty"`
+	Language     string   `json:"language,omitempty"`
+	AgentVersion string   `json:"agentVersion,omitempty"`
+}
+
+// PropertiesFrom picks the known properties out of a report. Agents disagree
+// on key spelling ("osName", "OS Name", "Process No.", "hostname"), so keys
+// are compared without case, spaces and punctuation.
+func PropertiesFrom(kvs []skywalking.Tag) Properties {
+	var p Properties
+	for _, kv := range kvs {
+		v := strings.TrimSpace(kv.Value)
+		if v == "" {
+			continue
+		}
+		switch normalizeKey(kv.Key) {
+		case "osname":
+			p.OSName = v
+		case "osversion":
+			p.OSVersion = v
+		case "hostname", "host":
+			p.HostName = v
+		case "processno", "processid", "pid":
+			if n, err := strconv.Atoi(v); err == nil {
+				p.ProcessID = n
+			}
+		case "ipaddress", "ipv4", "ipv6", "ip":
+			p.IPs = append(p.IPs, v)
+		case "language":
+			p.Language = v
+		case "agentversion":
+			p.AgentVersion = v
+		}
+	}
+	return p
+}
+
+func normalizeKey(k string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '.', '_', '-':
+			return -1
+		}
+		return r
+	}, strings.ToLower(k))
+}
+
+// Attributes maps the properties to OTel semantic resource attributes.
+func (p Properties) Attributes() []otel.Attribute {
+	var attrs []otel.Attribute
+	add := func(key, value string) {
+		if value != "" {
+			attrs = append(attrs, otel.Attribute{Key: key, Value: otel.AttributeVal{StringValue: value}})
+		}
+	}
+	add("host.name", p.HostName)
+	add("os.type", osType(p.OSName))
+	add("os.description", osDescription(p.OSName, p.OSVersion))
+	if p.ProcessID > 0 {
+		attrs = append(attrs, otel.Attribute{Key: "process.pid", Value: otel.AttributeVal{IntValue: int64(p.ProcessID)}})
+	}
+	add(converter.LanguageAttribute, converter.NormalizeLanguage(p.Language))
+	add("telemetry.auto.version", p.AgentVersion)
+	return attrs
+}
+
+// osType maps an OS name to the os.type values of the semantic conventions.
+func osType(name string) string {
+	n := strings.ToLower(name)
+	switch {
+	case n == "":
+		return ""
+	case strings.Contains(n, "windows"):
+		return "windows"
+	case strings.Contains(n, "linux"):
+		return "linux"
+	case strings.Contains(n, "mac"), strings.Contains(n, "darwin"):
+		return "darwin"
+	case strings.Contains(n, "freebsd"):
+		return "freebsd"
+	case strings.Contains(n, "sunos"), strings.Contains(n, "solaris"):
+		return "solaris"
+	case strings.Contains(n, "aix"):
+		return "aix"
+	default:
+		return n
+	}
+}
+
+func osDescription(name, version string) string {
+	switch {
+	case version == "":
+		return name
+	case name == "", strings.Contains(strings.ToLower(version), strings.ToLower(name)):
+		return version
+	default:
+		return name + " " + version
+	}
+}
+
+// ----------- Cache -----------
+
+type key struct{ service, instance string }
+
+type entry struct {
+	svc       converter.Service
+	instance  string
+	props     *Properties // nil until reported
+	attrs     []otel.Attribute
+	firstSeen time.Time
+	lastSeen  atomic.Int64 // unix nanos
+	stale     atomic.Bool  // set by CheckStale, cleared by any activity
+}
+
+func (e *entry) seen() {
+	e.lastSeen.Store(time.Now().UnixNano())
+	e.stale.Store(false)
+}
+
+// Info describes a cached instance.
+type Info struct {
+	Service    string      `json:"service"`
+	TeamID     string      `json:"teamID,omitempty"`
+	Type       string      `json:"type,omitempty"`
+	Instance   string      `json:"instance"`
+	Properties *Properties `json:"properties,omitempty"`
+	FirstSeen  time.Time   `json:"firstSeen"`
+	LastSeen   time.Time   `json:"lastSeen"`
+	Stale      bool        `json:"stale"`
+
+	// Attributes are the resource attributes from the properties.
+	Attributes []otel.Attribute `json:"-"`
+}
+
+func (e *entry) info(staleAfter time.Duration) Info {
+	last := time.Unix(0, e.lastSeen.Load())
+	return Info{
+		Service:    e.svc.Name,
+		TeamID:     e.svc.TeamID,
+		Type:       e.svc.Type,
+		Instance:   e.instance,
+		Properties: e.props,
+		FirstSeen:  e.firstSeen,
+		LastSeen:   last,
+		Stale:      time.Since(last) > staleAfter,
+		Attributes: e.attrs,
+	}
+}
+
+// Cache tracks instances by (service name, instance): their reported
+// properties and when they were last seen, through a report, a keepAlive
+// ping or a span. Entries idle for the TTL are dropped by Sweep.
+type Cache struct {
+	ttl     time.Duration
+	mu      sync.RWMutex
+	entries map[key]*entry
+}
+
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{ttl: ttl, entries: map[key]*entry{}}
+}
+
+// get returns the entry of an instance, creating it if needed.
+func (c *Cache) get(svc converter.Service, instance string) *entry {
+	k := key{svc.Name, instance}
+	c.mu.RLock()
+	e := c.entries[k]
+	c.mu.RUnlock()
+	if e != nil {
+		return e
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e = c.entries[k]; e == nil {
+		e = &entry{svc: svc, instance: instance, firstSeen: time.Now()}
+		c.entries[k] = e
+	}
+	return e
+}
+
+// Report stores an instance's properties, replacing earlier ones.
+func (c *Cache) Report(svc converter.Service, instance string, p Properties) {
+	e := c.get(svc, instance)
+	c.mu.Lock()
+	e.svc, e.props, e.attrs = svc, &p, p.Attributes()
+	c.mu.Unlock()
+	e.seen()
+}
+
+// Touch records a keepAlive ping.
+func (c *Cache) Touch(svc converter.Service, instance string) {
+	c.get(svc, instance).seen()
+}
+
+// Attributes returns the resource attributes of an instance, or nil, and
+// counts as activity of a known instance. The slice is shared and must not
+// be modified.
+func (c *Cache) Attributes(service, instance string) []otel.Attribute {
+	c.mu.RLock()
+	e := c.entries[key{service, instance}]
+	var attrs []otel.Attribute
+	if e != nil {
+		attrs = e.attrs
+	}
+	c.mu.RUnlock()
+	if e == nil {
+		return nil
+	}
+	e.seen()
+	return attrs
+}
+
+// List returns all instances sorted by service and instance; those silent
+// for longer than staleAfter are marked stale.
+func (c *Cache) List(staleAfter time.Duration) []Info {
+	c.mu.RLock()
+	out := make([]Info, 0, len(c.entries))
+	for _, e := range c.entries {
+		out = append(out, e.info(staleAfter))
+	}
+	c.mu.RUnlock()
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Service != out[j].Service {
+			return out[i].Service < out[j].Service
+		}
+		return out[i].Instance < out[j].Instance
+	})
+	return out
+}
+
+// CheckStale returns the instances that went silent for longer than
+// staleAfter since the last call; each silence is reported once.
+func (c *Cache) CheckStale(staleAfter time.Duration) []Info {
+	cutoff := time.Now().Add(-staleAfter).UnixNano()
+	var out []Info
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, e := range c.entries {
+		if e.lastSeen.Load() < cutoff && e.stale.CompareAndSwap(false, true) {
+			out = append(out, e.info(staleAfter))
+		}
+	}
+	return out
+}
+
+// Sweep drops entries older than the TTL and returns how many it dropped.
+func (c *Cache) Sweep() int {
+	cutoff := time.Now().Add(-c.ttl).UnixNano()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := 0
+	for k, e := range c.entries {
+		if e.lastSeen.Load() < cutoff {
+			delete(c.entries, k)
+			n++
+		}
+	}
+	return n
+}
+
+// Len returns the number of cached instances.
+func (c *Cache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.entries)
+}
@@ -3,8 +3,6 @@ package main
 import (
 	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
 	"io"
 	"log"
 	"net"
@@ -13,6 +11,7 @@ import (
 	"os/signal"
 	"runtime"
 	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -20,25 +19,61 @@ import (
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"google.golang.org/grpc"
 	"gopkg.in/natefinch/lumberjack.v2"
 
 	"skywalking_transformer/converter"
 	_ "skywalking_transformer/docs"
+	"skywalking_transformer/exporter"
+	"skywalking_transformer/metrics"
 	"skywalking_transformer/otel"
 	"skywalking_transformer/skywalking"
 )
 
 // ----------- Config (env-driven) -----------
 var (
-	collectorURL    string
-	receiverPort    string
-	queueSize       int
-	workerCount     int
-	batchSize       int
-	batchFlush      time.Duration
-	httpTimeout     time.Duration
-	shutdownTimeout time.Duration
-	queueDropOnFull bool
+	collectorURL     string
+	exportProtocol   string
+	grpcEndpoint     string
+	grpcInsecure     bool
+	grpcKeepalive    time.Duration
+	compression      string
+	compressLevel    int
+	receiverPort     string
+	grpcEnabled      bool
+	grpcPort         string
+	queueSize        int
+	workerCount      int
+	batchSize        int
+	batchFlush       time.Duration
+	httpTimeout      time.Duration
+	shutdownTimeout  time.Duration
+	queueDropOnFull  bool
+	queueFullMode    string
+	rejectTimeout    time.Duration
+	blockTimeout     time.Duration
+	rejectStatus     int
+	rejectRetryAfter int
+
+	maxDecompressedBytes int64
+
+	queueDir         string
+	queueMaxDisk     int64
+	queueSegmentSize int64
+	queueFsync       bool
+
+	retryPolicy   exporter.RetryPolicy
+	deadLetterDir string
+
+	tenantHeader  string
+	tenantDefault string
+
+	instanceTTL         time.Duration
+	instanceStaleAfter  time.Duration
+	instanceStaleEvents bool
+
+	breakerEnabled bool
+	breakerConfig  exporter.BreakerConfig
 )
 
 // ----------- Helpers for env parsing -----------
@@ -61,6 +96,14 @@ func getenvBool(key string, def bool) bool {
 	}
 	return def
 }
+func getenvFloat(key string, def float64) float64 {
+	if v := os.Getenv(key); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil && f >= 0 {
+			return f
+		}
+	}
+	return def
+}
 func getenvDurMS(key string, defMS int) time.Duration {
 	if v := os.Getenv(key); v != "" {
 		if n, err := strconv.Atoi(v); err == nil && n > 0 {
@@ -70,6 +113,23 @@ func getenvDurMS(key string, defMS int) time.Duration {
 	return time.Duration(defMS) * time.Millisecond
 }
 
+// getenvMap parses "k1=v1,k2=v2". Entries without "=" are skipped.
+func getenvMap(key string) map[string]string {
+	v := os.Getenv(key)
+	if v == "" {
+		return nil
+	}
+	out := map[string]string{}
+	for _, kv := range strings.Split(v, ",") {
+		k, val, ok := strings.Cut(kv, "=")
+		if k = strings.TrimSpace(k); !ok || k == "" {
+			continue
+		}
+		out[k] = strings.TrimSpace(val)
+	}
+	return out
+}
+
 // ----------- Logging -----------
 func initLogger() {
 	log.SetOutput(&lumberjack.Logger{
@@ -102,16 +162,16 @@ func makeHTTPClient(timeout time.Duration) *http.Client {
 // ----------- Async pipeline types -----------
 type job struct {
 	payload otel.OTelPayload
+	tenant  string
+	ack     func() // set when the job came from the disk queue
 }
 type combined struct {
 	payload otel.OTelPayload
+	tenant  string
+	acks    []func()
 }
 
-var (
-	jobCh      chan job
-	combinedCh chan combined
-	wg         sync.WaitGroup
-)
+var wg sync.WaitGroup
 
 // @title SkyWalking Collector API Example
 // @version 1.0
@@ -127,10 +187,30 @@ func main() {
 	if collectorURL == "" {
 		collectorURL = "http://labs.codexray.io:8041/v1/traces"
 	}
+	exportProtocol = os.Getenv("CODEXRAY_EXPORTER_PROTOCOL")
+	if exportProtocol == "" {
+		exportProtocol = exporter.ProtocolHTTPJSON
+	}
+	grpcEndpoint = os.Getenv("CODEXRAY_COLLECTOR_GRPC_ENDPOINT")
+	if grpcEndpoint == "" {
+		grpcEndpoint = "labs.codexray.io:4317"
+	}
+	grpcInsecure = getenvBool("CODEXRAY_COLLECTOR_GRPC_INSECURE", true)
+	grpcKeepalive = getenvDurMS("CODEXRAY_COLLECTOR_GRPC_KEEPALIVE_MS", 30000)
+	compression = os.Getenv("CODEXRAY_EXPORTER_COMPRESSION")
+	if compression == "" {
+		compression = exporter.CompressionNone
+	}
+	compressLevel = getenvInt("CODEXRAY_EXPORTER_COMPRESSION_LEVEL", 0)
 	receiverPort = os.Getenv("CODEXRAY_RECEIVER_PORT")
 	if receiverPort == "" {
 		receiverPort = "8081"
 	}
+	grpcEnabled = getenvBool("CODEXRAY_GRPC_ENABLED", true)
+	grpcPort = os.Getenv("CODEXRAY_GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "11800"
+	}
 
 	// Performance tuning (env)
 	queueSize = getenvInt("CODEXRAY_QUEUE_SIZE", 50000)
@@ -140,43 +220,113 @@ func main() {
 	httpTimeout = getenvDurMS("CODEXRAY_HTTP_TIMEOUT_MS", 5000)
 	shutdownTimeout = getenvDurMS("CODEXRAY_SHUTDOWN_TIMEOUT_MS", 10000)
 	queueDropOnFull = getenvBool("CODEXRAY_QUEUE_DROP_ON_FULL", false)
+	queueFullMode = os.Getenv("CODEXRAY_QUEUE_FULL_MODE")
+	if queueFullMode == "" {
+		queueFullMode = queueFullBlock
+		if queueDropOnFull {
+			queueFullMode = queueFullDrop
+		}
+	}
+	switch queueFullMode {
+	case queueFullBlock, queueFullDrop, queueFullReject:
+	default:
+		log.Fatalf("Unknown CODEXRAY_QUEUE_FULL_MODE %q (want block, drop or reject)", queueFullMode)
+	}
+	rejectTimeout = getenvDurMS("CODEXRAY_QUEUE_REJECT_TIMEOUT_MS", 1000)
+	blockTimeout = getenvDurMS("CODEXRAY_QUEUE_BLOCK_TIMEOUT_MS", 5000)
+	rejectStatus = getenvInt("CODEXRAY_QUEUE_REJECT_STATUS", http.StatusTooManyRequests)
+	if rejectStatus != http.StatusTooManyRequests && rejectStatus != http.StatusServiceUnavailable {
+		log.Fatalf("CODEXRAY_QUEUE_REJECT_STATUS must be 429 or 503, got %d", rejectStatus)
+	}
+	rejectRetryAfter = getenvInt("CODEXRAY_QUEUE_RETRY_AFTER_S", 5)
+	maxDecompressedBytes = int64(getenvInt("CODEXRAY_MAX_DECOMPRESSED_BODY_MB", 64)) << 20
+	queueDir = os.Getenv("CODEXRAY_QUEUE_DIR")
+	queueMaxDisk = int64(getenvInt("CODEXRAY_QUEUE_MAX_DISK_MB", 1024)) << 20
+	queueSegmentSize = int64(getenvInt("CODEXRAY_QUEUE_SEGMENT_MB", 16)) << 20
+	queueFsync = getenvBool("CODEXRAY_QUEUE_FSYNC", false)
+	retryPolicy = exporter.RetryPolicy{
+		MaxAttempts:    getenvInt("CODEXRAY_RETRY_MAX_ATTEMPTS", 5),
+		InitialBackoff: getenvDurMS("CODEXRAY_RETRY_INITIAL_BACKOFF_MS", 500),
+		MaxBackoff:     getenvDurMS("CODEXRAY_RETRY_MAX_BACKOFF_MS", 30000),
+		Jitter:         getenvFloat("CODEXRAY_RETRY_JITTER", 0.2),
+		MaxElapsed:     getenvDurMS("CODEXRAY_RETRY_MAX_ELAPSED_MS", 120000),
+	}
+	deadLetterDir = os.Getenv("CODEXRAY_DEAD_LETTER_DIR")
+	converter.SetResourceAttributes(getenvMap("CODEXRAY_RESOURCE_ATTRIBUTES"))
+	instanceTTL = getenvDurMS("CODEXRAY_INSTANCE_TTL_MS", 3600000)
+	instanceStaleAfter = getenvDurMS("CODEXRAY_INSTANCE_STALE_MS", 90000)
+	instanceStaleEvents = getenvBool("CODEXRAY_INSTANCE_STALE_EVENTS", false)
+	tenantHeader = os.Getenv("CODEXRAY_TENANT_HEADER")
+	tenantDefault = os.Getenv("CODEXRAY_TENANT_DEFAULT")
+	breakerEnabled = getenvBool("CODEXRAY_BREAKER_ENABLED", true)
+	breakerConfig = exporter.BreakerConfig{
+		FailureThreshold: getenvInt("CODEXRAY_BREAKER_FAILURE_THRESHOLD", 5),
+		OpenTimeout:      getenvDurMS("CODEXRAY_BREAKER_OPEN_MS", 10000),
+		HalfOpenProbes:   getenvInt("CODEXRAY_BREAKER_HALF_OPEN_PROBES", 1),
+	}
 
-	log.Printf("Using OTEL collector endpoint: %s", collectorURL)
+	exporterConfigs, err := loadExporterConfigs()
+	if err != nil {
+		log.Fatalf("Exporter config: %v", err)
+	}
+	for _, cfg := range exporterConfigs {
+		log.Printf("Exporter %s: %s to %s", cfg.name, cfg.protocol, cfg.endpoint())
+		if cfg.compression != exporter.CompressionNone {
+			log.Printf("Exporter %s: compressing exports with %s", cfg.name, cfg.compression)
+		}
+	}
 	log.Printf("Listening on port: %s", receiverPort)
+	if grpcEnabled {
+		log.Printf("Listening for SkyWalking gRPC on port: %s", grpcPort)
+	}
 
 	httpClient = makeHTTPClient(httpTimeout)
+	metrics.Gauge("export_compression_ratio", "Uncompressed/compressed bytes of compressed exports.", nil, exporter.CompressionRatio)
 
-	// Build pipeline
-	jobCh = make(chan job, queueSize)
-	combinedCh = make(chan combined, workerCount*2)
+	// Build one pipeline per exporter
+	for _, cfg := range exporterConfigs {
+		p, err := newPipeline(cfg)
+		if err != nil {
+			log.Fatalf("Exporter %s setup failed: %v", cfg.name, err)
+		}
+		pipelines = append(pipelines, p)
+	}
+	if err := loadRoutes(); err != nil {
+		log.Fatalf("Routing config: %v", err)
+	}
+	logRoutes()
+	if queueDir != "" {
+		log.Printf("Using disk queue in %s (max %d MB per exporter)", queueDir, queueMaxDisk>>20)
+	}
+	if deadLetterDir != "" {
+		log.Printf("Writing undeliverable batches to %s", deadLetterDir)
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
-
-	// Batcher
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		runBatcher(ctx)
-	}()
-
-	// Workers
-	for i := 0; i < workerCount; i++ {
-		wg.Add(1)
-		go func(id int) {
-			defer wg.Done()
-			runSender(ctx, id)
-		}(i + 1)
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	var watchers sync.WaitGroup
+	initInstances(watchCtx, &watchers)
+	for _, p := range pipelines {
+		p.start(ctx, &wg)
 	}
 
 	// HTTP server (Gin)
 	r := gin.New()
 	r.Use(gin.LoggerWithWriter(log.Writer()), gin.Recovery())
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
-	r.POST("/v3/segments", collectAndEnqueueHandler)
-	r.POST("/v3/management/reportProperties", reportPropertiesHandler)
-	r.POST("/v3/management/keepAlive", keepAliveHandler)
-	r.POST("/v3/clrMetricReports", clrMetricReportsHandler)
-	r.GET("/health", func(c *gin.Context) { c.JSON(200, gin.H{"status": "ok"}) })
+	v3 := r.Group("/v3", decompressBody(maxDecompressedBytes))
+	v3.POST("/segments", collectAndEnqueueHandler)
+	v3.POST("/management/reportProperties", reportPropertiesHandler)
+	v3.POST("/management/keepAlive", keepAliveHandler)
+	v3.POST("/logs", logsHandler)
+	v3.POST("/jvmMetrics", jvmMetricsHandler)
+	v3.POST("/meter/collect", meterCollectHandler)
+	v3.POST("/meter/collectBatch", meterCollectBatchHandler)
+	v3.POST("/clrMetricReports", clrMetricReportsHandler)
+	r.GET("/health", healthHandler)
+	r.GET("/metrics", gin.WrapH(metrics.Handler()))
+	r.GET("/instances", instancesHandler)
+	r.POST("/admin/deadletter/replay", replayDeadLetterHandler)
 
 	srv := &http.Server{
 		Addr:         ":" + receiverPort,
@@ -186,6 +336,22 @@ func main() {
 		IdleTimeout:  120 * time.Second,
 	}
 
+	// gRPC server (SkyWalking native protocol)
+	var grpcSrv *grpc.Server
+	if grpcEnabled {
+		lis, err := net.Listen("tcp", ":"+grpcPort)
+		if err != nil {
+			cancel()
+			log.Fatalf("gRPC listen failed: %v", err)
+		}
+		grpcSrv = newGRPCServer()
+		go func() {
+			if err := grpcSrv.Serve(lis); err != nil {
+				log.Printf("gRPC server error: %v", err)
+			}
+		}()
+	}
+
 	// Graceful shutdown
 	idleConnsClosed := make(chan struct{})
 	go func() {
@@ -193,14 +359,26 @@ func main() {
 		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 		<-sigCh
 		log.Println("Shutdown signal received...")
-		cancel()
-		close(jobCh)
+		// receivers first: their handlers may still wait for queue room,
+		// which only running batchers make
+		if grpcSrv != nil {
+			stopGRPCServer(grpcSrv, shutdownTimeout)
+		}
 		ctxTimeout, cancel2 := context.WithTimeout(context.Background(), shutdownTimeout)
 		if err := srv.Shutdown(ctxTimeout); err != nil {
 			log.Printf("HTTP server shutdown error: %v", err)
 		}
 		defer cancel2()
+		stopWatch()
+		watchers.Wait()
+		for _, p := range pipelines {
+			p.stopIntake()
+		}
+		cancel()
 		wg.Wait()
+		for _, p := range pipelines {
+			p.close()
+		}
 		close(idleConnsClosed)
 	}()
 
@@ -214,13 +392,38 @@ func main() {
 }
 
 // ----------- Handlers -----------
-func reportPropertiesHandler(c *gin.Context) {
-	c.JSON(200, gin.H{"status": "received"})
+func healthHandler(c *gin.Context) {
+	status := "ok"
+	exporters := gin.H{}
+	for _, p := range pipelines {
+		breakerState := "disabled"
+		if p.breaker != nil {
+			state := p.breaker.State()
+			breakerState = state.String()
+			if state != exporter.BreakerClosed {
+				// still accepting data, but exports are failing fast
+				status = "degraded"
+			}
+		}
+		exporters[p.name] = gin.H{"circuit_breaker": breakerState}
+	}
+	c.JSON(200, gin.H{
+		"status":            status,
+		"exporters":         exporters,
+		"compression":       compression,
+		"compression_ratio": exporter.CompressionRatio(),
+	})
 }
 
 func collectAndEnqueueHandler(c *gin.Context) {
 	body, err := c.GetRawData()
 	if err != nil {
+		if isBodyTooLarge(err) {
+			metrics.ConversionFailures.WithLabelValues(c.FullPath(), "too_large").Inc()
+			c.JSON(413, gin.H{"error": "decompressed body too large"})
+			return
+		}
+		metrics.ConversionFailures.WithLabelValues(c.FullPath(), "read").Inc()
 		c.JSON(400, gin.H{"error": "failed to read body"})
 		return
 	}
@@ -229,121 +432,78 @@ func collectAndEnqueueHandler(c *gin.Context) {
 	var payload []skywalking.TraceSegment
 	if err := c.ShouldBindJSON(&payload); err != nil {
 		log.Printf("Bind error: %v", err)
+		metrics.ConversionFailures.WithLabelValues(c.FullPath(), "decode").Inc()
 		c.JSON(400, gin.H{"error": err.Error()})
 		return
 	}
+	metrics.SegmentsReceived.WithLabelValues(c.FullPath()).Add(float64(len(payload)))
 
+	// the reject timeout is a budget for the whole request
+	deadline := enqueueDeadline()
 	enqueued := 0
 	for i := range payload {
 		segment := &payload[i] // Use pointer to avoid copying
-		otelPayload := converter.SkywalkingToOtel(segment)
-		j := job{payload: otelPayload}
-		if queueDropOnFull {
-			select {
-			case jobCh <- j:
-				enqueued++
-			default:
-				log.Printf("Queue full, dropping payload")
-			}
-		} else {
-			jobCh <- j
+		if enqueueSegment(segment, deadline) {
 			enqueued++
 		}
 	}
+	rejected := len(payload) - enqueued
 
-	c.JSON(200, gin.H{"status": "queued", "enqueued": enqueued})
-}
-
-func keepAliveHandler(c *gin.Context) {
-	c.JSON(200, gin.H{"status": "alive"})
-}
-func clrMetricReportsHandler(c *gin.Context) {
-	c.JSON(200, gin.H{"status": "ok"})
+	if rejected > 0 && queueFullMode == queueFullReject {
+		c.Header("Retry-After", strconv.Itoa(rejectRetryAfter))
+		c.JSON(rejectStatus, gin.H{"status": "rejected", "enqueued": enqueued, "accepted": enqueued, "rejected": rejected})
+		return
+	}
+	c.JSON(200, gin.H{"status": "queued", "enqueued": enqueued, "accepted": enqueued, "rejected": rejected})
 }
 
-// ----------- Batcher & Sender -----------
-func runBatcher(ctx context.Context) {
-	ticker := time.NewTicker(batchFlush)
-	defer ticker.Stop()
-	var buf []otel.OTelPayload
-	flush := func() {
-		if len(buf) == 0 {
-			return
-		}
-		select {
-		case combinedCh <- combined{payload: mergePayloads(buf)}:
-		case <-ctx.Done():
-		}
-		buf = buf[:0]
-	}
+// What to do with a segment when jobCh is full (CODEXRAY_QUEUE_FULL_MODE).
+const (
+	queueFullBlock  = "block"  // wait until the deadline, then drop the segment
+	queueFullDrop   = "drop"   // drop the segment, still answer 200
+	queueFullReject = "reject" // wait until the deadline, then tell the agent to back off
+)
 
-	for {
-		select {
-		case <-ctx.Done():
-			flush()
-			close(combinedCh)
-			return
-		case j, ok := <-jobCh:
-			if !ok {
-				flush()
-				close(combinedCh)
-				return
-			}
-			buf = append(buf, j.payload)
-			if len(buf) >= batchSize {
-				flush()
-			}
-		case <-ticker.C:
-			flush()
-		}
+// enqueueSegment converts a segment and hands it to the batcher. It reports
+// false when the segment was dropped or rejected because the queue was full;
+// in reject mode it waits until deadline at most.
+func enqueueSegment(segment *skywalking.TraceSegment, deadline time.Time) bool {
+	targets := routeSegment(segment)
+	if len(targets) == 0 {
+		countUnrouted()
+		return true
 	}
+	metrics.SpansConverted.Add(float64(len(segment.Spans)))
+	return enqueuePayload(converter.SkywalkingToOtel(segment), targets, deadline)
 }
 
-func runSender(ctx context.Context, id int) {
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case cmb, ok := <-combinedCh:
-			if !ok {
-				return
-			}
-			if err := sendToCollector(cmb.payload); err != nil {
-				log.Printf("[worker %d] send error: %v", id, err)
-				// retry once
-				if err2 := sendToCollector(cmb.payload); err2 != nil {
-					log.Printf("[worker %d] retry error: %v", id, err2)
-				}
-			}
+// enqueuePayload hands a converted payload to the pipelines of its exporters.
+// They share the request's deadline, so a full queue delays the request by
+// that much at most whatever the number of exporters and payloads. It
+// reports true only if all of them queued it; when one exporter rejects, the
+// agent resends and the others receive the segment twice.
+func enqueuePayload(otelPayload otel.OTelPayload, targets []*pipeline, deadline time.Time) bool {
+	ok := true
+	for _, p := range targets {
+		if !p.enqueue(otelPayload, deadline) {
+			ok = false
 		}
 	}
+	return ok
 }
 
-func mergePayloads(items []otel.OTelPayload) otel.OTelPayload {
-	out := otel.OTelPayload{ResourceSpans: make([]otel.ResourceSpan, 0, len(items))}
-	for _, it := range items {
-		out.ResourceSpans = append(out.ResourceSpans, it.ResourceSpans...)
-	}
-	return out
-}
-
-func sendToCollector(p otel.OTelPayload) error {
-	payloadBytes, err := json.Marshal(p)
-	if err != nil {
-		return err
-	}
-	req, err := http.NewRequest(http.MethodPost, collectorURL, bytes.NewBuffer(payloadBytes))
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode >= 300 {
-		return fmt.Errorf("otel backend returned status: %s", resp.Status)
+// enqueueDeadline is the deadline for a request to find room in the queues:
+// CODEXRAY_QUEUE_REJECT_TIMEOUT_MS in reject mode,
+// CODEXRAY_QUEUE_BLOCK_TIMEOUT_MS in block mode. It is zero, no limit, when
+// the block timeout is 0.
+func enqueueDeadline() time.Time {
+	switch queueFullMode {
+	case queueFullReject:
+		return time.Now().Add(rejectTimeout)
+	case queueFullBlock:
+		if blockTimeout > 0 {
+			return time.Now().Add(blockTimeout)
+		}
 	}
-	return nil
+	return time.Time{}
 }
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skywalking_transformer/converter"
+	"skywalking_transformer/instance"
+	"skywalking_transformer/metrics"
+	"skywalking_transformer/otel"
+	"skywalking_transformer/skywalking"
+	agentv3 "skywalking_transformer/skywalking/v3"
+)
+
+// ----------- Instance properties -----------
+
+// instances caches reportProperties per service instance; the converter adds
+// them to the resource of every later segment from that instance.
+var instances *instance.Cache
+
+// initInstances sets up the instance cache. The stale watcher feeds the
+// pipelines, so it runs in wg to let shutdown wait for it before closing them.
+func initInstances(ctx context.Context, wg *sync.WaitGroup) {
+	instances = instance.NewCache(instanceTTL)
+	converter.SetInstanceLookup(func(service, inst string) []otel.Attribute {
+		return instances.Attributes(service, inst)
+	})
+	metrics.Gauge("instances_cached", "Service instances with cached properties.", nil, func() float64 {
+		return float64(instances.Len())
+	})
+	metrics.Gauge("instances_stale", "Known instances silent for longer than CODEXRAY_INSTANCE_STALE_MS.", nil, func() float64 {
+		n := 0
+		for _, info := range instances.List(instanceStaleAfter) {
+			if info.Stale {
+				n++
+			}
+		}
+		return float64(n)
+	})
+	go func() {
+		t := time.NewTicker(time.Minute)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				if n := instances.Sweep(); n > 0 {
+					log.Printf("Forgot %d idle instances", n)
+				}
+			}
+		}
+	}()
+	if instanceStaleEvents {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runStaleWatcher(ctx)
+		}()
+	}
+}
+
+func reportInstanceProperties(p skywalking.InstanceProperties) {
+	// keyed by the parsed name, like the converter looks it up
+	svc, _ := converter.ParseService(p.Service)
+	instances.Report(svc, p.ServiceInstance, instance.PropertiesFrom(p.Properties))
+}
+
+func instanceKeepAlive(p skywalking.InstancePing) {
+	svc, _ := converter.ParseService(p.Service)
+	instances.Touch(svc, p.ServiceInstance)
+}
+
+// runStaleWatcher emits an OTLP log event for every instance that stops
+// pinging for longer than CODEXRAY_INSTANCE_STALE_MS.
+func runStaleWatcher(ctx context.Context) {
+	every := instanceStaleAfter / 3
+	if every < time.Second {
+		every = time.Second
+	}
+	t := time.NewTicker(every)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			for _, info := range instances.CheckStale(instanceStaleAfter) {
+				log.Printf("Instance %s of %s silent since %s", info.Instance, info.Service, info.LastSeen.Format(time.RFC3339))
+				svc := converter.Service{Name: info.Service, TeamID: info.TeamID, Type: info.Type}
+				if targets := routeService(svc, info.Instance); len(targets) > 0 {
+					enqueuePayload(staleEvent(info), targets, enqueueDeadline())
+				}
+			}
+		}
+	}
+}
+
+func staleEvent(info instance.Info) otel.OTelPayload {
+	attrs := []otel.Attribute{
+		{Key: "service.name", Value: otel.AttributeVal{StringValue: info.Service}},
+		{Key: "service.instance.id", Value: otel.AttributeVal{StringValue: info.Instance}},
+	}
+	if info.TeamID != "" {
+		attrs = append(attrs, otel.Attribute{Key: converter.TeamIDAttribute, Value: otel.AttributeVal{StringValue: info.TeamID}})
+	}
+	attrs = append(attrs, info.Attributes...)
+	now := strconv.FormatInt(time.Now().UnixNano(), 10)
+	record := otel.LogRecord{
+		TimeUnixNano:         now,
+		ObservedTimeUnixNano: now,
+		SeverityNumber:       otel.SeverityWarn,
+		SeverityText:         "WARN",
+		Body:                 otel.AttributeVal{StringValue: "instance stopped reporting"},
+		Attributes: []otel.Attribute{
+			{Key: "event.name", Value: otel.AttributeVal{StringValue: "codexray.instance.stale"}},
+			{Key: "codexray.instance.last_seen", Value: otel.AttributeVal{StringValue: info.LastSeen.UTC().Format(time.RFC3339)}},
+			{Key: "codexray.instance.silent_ms", Value: otel.AttributeVal{IntValue: time.Since(info.LastSeen).Milliseconds()}},
+		},
+	}
+	return otel.OTelPayload{ResourceLogs: []otel.ResourceLogs{{
+		Resource:  otel.Resource{Attributes: attrs},
+		ScopeLogs: []otel.ScopeLogs{{LogRecords: []otel.LogRecord{record}}},
+	}}}
+}
+
+// instancesHandler lists known instances; ?status=active or ?status=stale
+// filters them.
+func instancesHandler(c *gin.Context) {
+	status := c.Query("status")
+	list := []instance.Info{}
+	active, stale := 0, 0
+	for _, info := range instances.List(instanceStaleAfter) {
+		if info.Stale {
+			stale++
+		} else {
+			active++
+		}
+		if (status == "stale" && !info.Stale) || (status == "active" && info.Stale) {
+			continue
+		}
+		list = append(list, info)
+	}
+	c.JSON(200, gin.H{"active": active, "stale": stale, "instances": list})
+}
+
+func reportPropertiesHandler(c *gin.Context) {
+	var props skywalking.InstanceProperties
+	if err := c.ShouldBindJSON(&props); err != nil {
+		metrics.ConversionFailures.WithLabelValues(c.FullPath(), "decode").Inc()
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	reportInstanceProperties(props)
+	c.JSON(200, gin.H{"status": "received"})
+}
+
+func keepAliveHandler(c *gin.Context) {
+	var ping skywalking.InstancePing
+	if err := c.ShouldBindJSON(&ping); err != nil {
+		metrics.ConversionFailures.WithLabelValues(c.FullPath(), "decode").Inc()
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	instanceKeepAlive(ping)
+	c.JSON(200, gin.H{"status": "alive"})
+}
+
+// managementService is the gRPC counterpart of the /v3/management routes.
+type managementService struct {
+	agentv3.UnimplementedManagementServiceServer
+}
+
+func (s *managementService) ReportInstanceProperties(_ context.Context, in *agentv3.InstanceProperties) (*agentv3.Commands, error) {
+	reportInstanceProperties(skywalking.InstancePropertiesFromProto(in))
+	return &agentv3.Commands{}, nil
+}
+
+func (s *managementService) KeepAlive(_ context.Context, in *agentv3.InstancePingPkg) (*agentv3.Commands, error) {
+	instanceKeepAlive(skywalking.InstancePingFromProto(in))
+	return &agentv3.Commands{}, nil
+}
@@ -0,0 +1,106 @@
+// Package metrics exposes the transformer's own pipeline metrics in the
+// Prometheus format.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "codexray_transformer"
+
+var (
+	SegmentsReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "segments_received_total",
+		Help:      "SkyWalking segments received, by receiver endpoint.",
+	}, []string{"endpoint"})
+
+	SpansConverted = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "spans_converted_total",
+		Help:      "SkyWalking spans converted to OTLP.",
+	})
+
+	MetricReportsReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "metric_reports_received_total",
+		Help:      "Agent metric reports received, by receiver endpoint.",
+	}, []string{"endpoint"})
+
+	LogsReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "logs_received_total",
+		Help:      "SkyWalking log entries received, by receiver endpoint.",
+	}, []string{"endpoint"})
+
+	DataPointsConverted = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "data_points_converted_total",
+		Help:      "Agent metric data points converted to OTLP.",
+	})
+
+	DataPointsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "data_points_dropped_total",
+		Help:      "Agent metric data points left out of the OTLP payload, by reason: non_finite.",
+	}, []string{"reason"})
+
+	ConversionFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "conversion_failures_total",
+		Help:      "Requests or records that could not be decoded or converted, by endpoint and reason.",
+	}, []string{"endpoint", "reason"})
+
+	BatchSpans = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "batch_spans",
+		Help:      "Spans per batch handed to the exporter.",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 8), // 1 .. 16384
+	}, []string{"exporter"})
+
+	ExportDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "export_duration_seconds",
+		Help:      "Latency of single export attempts to the collector.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"exporter"})
+
+	ExportResults = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "export_requests_total",
+		Help:      "Export attempts by outcome: HTTP status code, gRPC code, network_error, timeout, encode_error or circuit_open.",
+	}, []string{"exporter", "status_code"})
+
+	ExportRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "export_retries_total",
+		Help:      "Export attempts that were retries of a failed attempt.",
+	}, []string{"exporter"})
+
+	Dropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "dropped_total",
+		Help:      "Payloads not accepted or lost, by reason: queue_full, rejected, export_failed, disk_evicted.",
+	}, []string{"exporter", "reason"})
+
+	DeadLettered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "dead_lettered_batches_total",
+		Help:      "Batches written to the dead letter directory.",
+	}, []string{"exporter"})
+)
+
+// Gauge registers a gauge whose value is read from fn at scrape time. labels
+// may be nil.
+func Gauge(name, help string, labels prometheus.Labels, fn func() float64) {
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{Namespace: namespace, Name: name, Help: help, ConstLabels: labels}, fn)
+}
+
+// Handler serves the metrics, including the Go runtime and process collectors.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ----------- Request decompression -----------
+
+// decompressBody transparently inflates gzip/deflate request bodies so the
+// handlers always read plain JSON. The inflated size is capped at maxBytes to
+// defuse zip bombs; reading past it fails with *http.MaxBytesError.
+func decompressBody(maxBytes int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		encoding := strings.ToLower(strings.TrimSpace(c.GetHeader("Content-Encoding")))
+		var (
+			rc  io.ReadCloser
+			err error
+		)
+		switch encoding {
+		case "", "identity":
+			c.Next()
+			return
+		case "gzip", "x-gzip":
+			rc, err = gzip.NewReader(c.Request.Body)
+		case "deflate":
+			rc, err = newDeflateReader(c.Request.Body)
+		default:
+			c.AbortWithStatusJSON(415, gin.H{"error": "unsupported Content-Encoding: " + encoding})
+			return
+		}
+		if err != nil {
+			log.Printf("Decompress error (%s): %v", encoding, err)
+			c.AbortWithStatusJSON(400, gin.H{"error": "invalid " + encoding + " body"})
+			return
+		}
+		defer rc.Close()
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, rc, maxBytes)
+		c.Request.Header.Del("Content-Encoding")
+		c.Request.Header.Del("Content-Length")
+		c.Request.ContentLength = -1
+		c.Next()
+	}
+}
+
+// newDeflateReader handles both meanings of "deflate" found in the wild: the
+// zlib-wrapped stream the RFC asks for and the raw DEFLATE some clients send.
+func newDeflateReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(2)
+	if err != nil {
+		return nil, err
+	}
+	// zlib header: CM=8 in the low nibble and the 16-bit header a multiple of 31
+	if header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+		return zlib.NewReader(br)
+	}
+	return flate.NewReader(br), nil
+}
+
+// isBodyTooLarge reports whether err came from hitting the decompressed size cap.
+func isBodyTooLarge(err error) bool {
+	var maxErr *http.MaxBytesError
+	return errors.As(err, &maxErr)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func compress(t *testing.T, encoding string, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "zlib":
+		w = zlib.NewWriter(&buf)
+	case "raw":
+		fw, err := flate.NewWriter(&buf, flate.DefaultCompression)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w = fw
+	default:
+		return data
+	}
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecompressBody(t *testing.T) {
+	const limit = 1 << 10
+	small := []byte(`{"traceId":"abc"}`)
+	bomb := make([]byte, 1<<20) // 1 MiB of zeros, a few KiB compressed
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	// echoes the body like collectAndEnqueueHandler reads it
+	r.POST("/v3/test", decompressBody(limit), func(c *gin.Context) {
+		body, err := c.GetRawData()
+		if err != nil {
+			if isBodyTooLarge(err) {
+				c.String(http.StatusRequestEntityTooLarge, "too large")
+				return
+			}
+			c.String(http.StatusBadRequest, "read error")
+			return
+		}
+		c.Data(http.StatusOK, "application/json", body)
+	})
+
+	tests := []struct {
+		name       string
+		encoding   string // Content-Encoding header
+		body       []byte
+		wantStatus int
+		wantBody   []byte
+	}{
+		{"plain", "", small, http.StatusOK, small},
+		{"identity", "identity", small, http.StatusOK, small},
+		{"gzip", "gzip", compress(t, "gzip", small), http.StatusOK, small},
+		{"x-gzip", "x-gzip", compress(t, "gzip", small), http.StatusOK, small},
+		{"zlib deflate", "deflate", compress(t, "zlib", small), http.StatusOK, small},
+		{"raw deflate", "deflate", compress(t, "raw", small), http.StatusOK, small},
+		{"gzip bomb", "gzip", compress(t, "gzip", bomb), http.StatusRequestEntityTooLarge, nil},
+		{"deflate bomb", "deflate", compress(t, "zlib", bomb), http.StatusRequestEntityTooLarge, nil},
+		{"unsupported encoding", "br", small, http.StatusUnsupportedMediaType, nil},
+		{"corrupt gzip", "gzip", []byte("not gzip at all"), http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v3/test", bytes.NewReader(tt.body))
+			if tt.encoding != "" {
+				req.Header.Set("Content-Encoding", tt.encoding)
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", rec.Code, tt.wantStatus, strings.TrimSpace(rec.Body.String()))
+			}
+			if tt.wantBody != nil && !bytes.Equal(rec.Body.Bytes(), tt.wantBody) {
+				t.Errorf("body = %q, want %q", rec.Body.Bytes(), tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package otel
+
+import (
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+// Severity numbers of the OTLP log data model, one per range.
+const (
+	SeverityTrace = 1
+	SeverityDebug = 5
+	SeverityInfo  = 9
+	SeverityWarn  = 13
+	SeverityError = 17
+	SeverityFatal = 21
+)
+
+type ResourceLogs struct {
+	Resource  Resource    `json:"resource"`
+	ScopeLogs []ScopeLogs `json:"scopeLogs"`
+}
+
+type ScopeLogs struct {
+	LogRecords []LogRecord `json:"logRecords"`
+}
+
+type LogRecord struct {
+	TimeUnixNano         string       `json:"timeUnixNano,omitempty"`
+	ObservedTimeUnixNano string       `json:"observedTimeUnixNano,omitempty"`
+	SeverityNumber       int          `json:"severityNumber,omitempty"`
+	SeverityText         string       `json:"severityText,omitempty"`
+	Body                 AttributeVal `json:"body"`
+	Attributes           []Attribute  `json:"attributes,omitempty"`
+	TraceID              string       `json:"traceId,omitempty"`
+	SpanID               string       `json:"spanId,omitempty"`
+}
+
+// LogRecordCount returns the number of log records in the payload.
+func (p OTelPayload) LogRecordCount() int {
+	n := 0
+	for i := range p.ResourceLogs {
+		for j := range p.ResourceLogs[i].ScopeLogs {
+			n += len(p.ResourceLogs[i].ScopeLogs[j].LogRecords)
+		}
+	}
+	return n
+}
+
+// LogsToProto converts the payload's logs into the OTLP protobuf export
+// request.
+func (p OTelPayload) LogsToProto() *collogspb.ExportLogsServiceRequest {
+	req := &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: make([]*logspb.ResourceLogs, 0, len(p.ResourceLogs)),
+	}
+	for i := range p.ResourceLogs {
+		rl := &p.ResourceLogs[i]
+		out := &logspb.ResourceLogs{
+			Resource:  resourceToProto(rl.Resource),
+			ScopeLogs: make([]*logspb.ScopeLogs, 0, len(rl.ScopeLogs)),
+		}
+		for j := range rl.ScopeLogs {
+			sl := &rl.ScopeLogs[j]
+			records := make([]*logspb.LogRecord, 0, len(sl.LogRecords))
+			for k := range sl.LogRecords {
+				r := &sl.LogRecords[k]
+				records = append(records, &logspb.LogRecord{
+					TimeUnixNano:         parseNano(r.TimeUnixNano),
+					ObservedTimeUnixNano: parseNano(r.ObservedTimeUnixNano),
+					SeverityNumber:       logspb.SeverityNumber(r.SeverityNumber),
+					SeverityText:         r.SeverityText,
+					Body:                 r.Body.toProto(),
+					Attributes:           attributesToProto(r.Attributes),
+					TraceId:              decodeID(r.TraceID),
+					SpanId:               decodeID(r.SpanID),
+				})
+			}
+			out.ScopeLogs = append(out.ScopeLogs, &logspb.ScopeLogs{LogRecords: records})
+		}
+		req.ResourceLogs = append(req.ResourceLogs, out)
+	}
+	return req
+}
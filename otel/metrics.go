@@ -0,0 +1,319 @@
+package otel
+
+import (
+	"encoding/json"
+	"strconv"
+
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+// Aggregation temporalities of sums.
+const (
+	TemporalityDelta      = 1
+	TemporalityCumulative = 2
+)
+
+type ResourceMetrics struct {
+	Resource     Resource       `json:"resource"`
+	ScopeMetrics []ScopeMetrics `json:"scopeMetrics"`
+}
+
+type ScopeMetrics struct {
+	Metrics []Metric `json:"metrics"`
+}
+
+// Metric holds exactly one of the data kinds.
+type Metric struct {
+	Name                 string                `json:"name"`
+	Description          string                `json:"description,omitempty"`
+	Unit                 string                `json:"unit,omitempty"`
+	Gauge                *Gauge                `json:"gauge,omitempty"`
+	Sum                  *Sum                  `json:"sum,omitempty"`
+	Histogram            *Histogram            `json:"histogram,omitempty"`
+	ExponentialHistogram *ExponentialHistogram `json:"exponentialHistogram,omitempty"`
+}
+
+type Gauge struct {
+	DataPoints []NumberDataPoint `json:"dataPoints"`
+}
+
+type Sum struct {
+	DataPoints             []NumberDataPoint `json:"dataPoints"`
+	AggregationTemporality int               `json:"aggregationTemporality"`
+	IsMonotonic            bool              `json:"isMonotonic,omitempty"`
+}
+
+type Histogram struct {
+	DataPoints             []HistogramDataPoint `json:"dataPoints"`
+	AggregationTemporality int                  `json:"aggregationTemporality"`
+}
+
+type ExponentialHistogram struct {
+	DataPoints             []ExponentialHistogramDataPoint `json:"dataPoints"`
+	AggregationTemporality int                             `json:"aggregationTemporality"`
+}
+
+// NumberDataPoint sets one of AsInt and AsDouble. 64-bit integers are strings
+// in OTLP/JSON, like the timestamps.
+type NumberDataPoint struct {
+	Attributes        []Attribute `json:"attributes,omitempty"`
+	StartTimeUnixNano string      `json:"startTimeUnixNano,omitempty"`
+	TimeUnixNano      string      `json:"timeUnixNano"`
+	AsInt             *int64      `json:"asInt,string,omitempty"`
+	AsDouble          *float64    `json:"asDouble,omitempty"`
+	Exemplars         []Exemplar  `json:"exemplars,omitempty"`
+	Flags             uint32      `json:"flags,omitempty"`
+}
+
+// HistogramDataPoint has one more bucket count than explicit bounds; bucket i
+// counts values in (bounds[i-1], bounds[i]].
+type HistogramDataPoint struct {
+	Attributes        []Attribute `json:"attributes,omitempty"`
+	StartTimeUnixNano string      `json:"startTimeUnixNano,omitempty"`
+	TimeUnixNano      string      `json:"timeUnixNano"`
+	Count             uint64      `json:"count,string"`
+	Sum               *float64    `json:"sum,omitempty"`
+	BucketCounts      Counts      `json:"bucketCounts,omitempty"`
+	ExplicitBounds    []float64   `json:"explicitBounds,omitempty"`
+	Exemplars         []Exemplar  `json:"exemplars,omitempty"`
+	Flags             uint32      `json:"flags,omitempty"`
+	Min               *float64    `json:"min,omitempty"`
+	Max               *float64    `json:"max,omitempty"`
+}
+
+// ExponentialHistogramDataPoint has buckets of base 2^(2^-scale); bucket
+// index i covers (base^i, base^(i+1)], shifted by the offset of its side.
+type ExponentialHistogramDataPoint struct {
+	Attributes        []Attribute         `json:"attributes,omitempty"`
+	StartTimeUnixNano string              `json:"startTimeUnixNano,omitempty"`
+	TimeUnixNano      string              `json:"timeUnixNano"`
+	Count             uint64              `json:"count,string"`
+	Sum               *float64            `json:"sum,omitempty"`
+	Scale             int32               `json:"scale"`
+	ZeroCount         uint64              `json:"zeroCount,string"`
+	Positive          *ExponentialBuckets `json:"positive,omitempty"`
+	Negative          *ExponentialBuckets `json:"negative,omitempty"`
+	Flags             uint32              `json:"flags,omitempty"`
+	Exemplars         []Exemplar          `json:"exemplars,omitempty"`
+	Min               *float64            `json:"min,omitempty"`
+	Max               *float64            `json:"max,omitempty"`
+	ZeroThreshold     float64             `json:"zeroThreshold,omitempty"`
+}
+
+type ExponentialBuckets struct {
+	Offset       int32  `json:"offset"`
+	BucketCounts Counts `json:"bucketCounts,omitempty"`
+}
+
+// Exemplar is a sample measurement, optionally tied to the span it was
+// recorded in (hex IDs, like OTelSpan). Sets one of AsInt and AsDouble.
+type Exemplar struct {
+	FilteredAttributes []Attribute `json:"filteredAttributes,omitempty"`
+	TimeUnixNano       string      `json:"timeUnixNano"`
+	AsInt              *int64      `json:"asInt,string,omitempty"`
+	AsDouble           *float64    `json:"asDouble,omitempty"`
+	SpanID             string      `json:"spanId,omitempty"`
+	TraceID            string      `json:"traceId,omitempty"`
+}
+
+// Counts are bucket counts, encoded as strings in OTLP/JSON.
+type Counts []uint64
+
+func (c Counts) MarshalJSON() ([]byte, error) {
+	s := make([]string, len(c))
+	for i, n := range c {
+		s[i] = strconv.FormatUint(n, 10)
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON accepts strings and numbers, like OTLP/JSON receivers.
+func (c *Counts) UnmarshalJSON(b []byte) error {
+	var raw []json.Number // takes quoted numbers as well
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	out := make(Counts, len(raw))
+	for i, n := range raw {
+		v, err := strconv.ParseUint(string(n), 10, 64)
+		if err != nil {
+			return err
+		}
+		out[i] = v
+	}
+	*c = out
+	return nil
+}
+
+// DataPointCount returns the number of data points in the payload.
+func (p OTelPayload) DataPointCount() int {
+	n := 0
+	for i := range p.ResourceMetrics {
+		for j := range p.ResourceMetrics[i].ScopeMetrics {
+			for _, m := range p.ResourceMetrics[i].ScopeMetrics[j].Metrics {
+				switch {
+				case m.Gauge != nil:
+					n += len(m.Gauge.DataPoints)
+				case m.Sum != nil:
+					n += len(m.Sum.DataPoints)
+				case m.Histogram != nil:
+					n += len(m.Histogram.DataPoints)
+				case m.ExponentialHistogram != nil:
+					n += len(m.ExponentialHistogram.DataPoints)
+				}
+			}
+		}
+	}
+	return n
+}
+
+// MetricsToProto converts the payload's metrics into the OTLP protobuf export
+// request.
+func (p OTelPayload) MetricsToProto() *colmetricspb.ExportMetricsServiceRequest {
+	req := &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: make([]*metricspb.ResourceMetrics, 0, len(p.ResourceMetrics)),
+	}
+	for i := range p.ResourceMetrics {
+		rm := &p.ResourceMetrics[i]
+		out := &metricspb.ResourceMetrics{
+			Resource:     resourceToProto(rm.Resource),
+			ScopeMetrics: make([]*metricspb.ScopeMetrics, 0, len(rm.ScopeMetrics)),
+		}
+		for j := range rm.ScopeMetrics {
+			sm := &rm.ScopeMetrics[j]
+			metrics := make([]*metricspb.Metric, 0, len(sm.Metrics))
+			for k := range sm.Metrics {
+				metrics = append(metrics, metricToProto(&sm.Metrics[k]))
+			}
+			out.ScopeMetrics = append(out.ScopeMetrics, &metricspb.ScopeMetrics{Metrics: metrics})
+		}
+		req.ResourceMetrics = append(req.ResourceMetrics, out)
+	}
+	return req
+}
+
+func metricToProto(m *Metric) *metricspb.Metric {
+	out := &metricspb.Metric{Name: m.Name, Description: m.Description, Unit: m.Unit}
+	switch {
+	case m.Gauge != nil:
+		out.Data = &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+			DataPoints: numberPointsToProto(m.Gauge.DataPoints),
+		}}
+	case m.Sum != nil:
+		out.Data = &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+			DataPoints:             numberPointsToProto(m.Sum.DataPoints),
+			AggregationTemporality: metricspb.AggregationTemporality(m.Sum.AggregationTemporality),
+			IsMonotonic:            m.Sum.IsMonotonic,
+		}}
+	case m.Histogram != nil:
+		out.Data = &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+			DataPoints:             histogramPointsToProto(m.Histogram.DataPoints),
+			AggregationTemporality: metricspb.AggregationTemporality(m.Histogram.AggregationTemporality),
+		}}
+	case m.ExponentialHistogram != nil:
+		out.Data = &metricspb.Metric_ExponentialHistogram{ExponentialHistogram: &metricspb.ExponentialHistogram{
+			DataPoints:             exponentialPointsToProto(m.ExponentialHistogram.DataPoints),
+			AggregationTemporality: metricspb.AggregationTemporality(m.ExponentialHistogram.AggregationTemporality),
+		}}
+	}
+	return out
+}
+
+func numberPointsToProto(points []NumberDataPoint) []*metricspb.NumberDataPoint {
+	out := make([]*metricspb.NumberDataPoint, 0, len(points))
+	for i := range points {
+		dp := &points[i]
+		pb := &metricspb.NumberDataPoint{
+			Attributes:        attributesToProto(dp.Attributes),
+			StartTimeUnixNano: parseNano(dp.StartTimeUnixNano),
+			TimeUnixNano:      parseNano(dp.TimeUnixNano),
+			Exemplars:         exemplarsToProto(dp.Exemplars),
+			Flags:             dp.Flags,
+		}
+		switch {
+		case dp.AsInt != nil:
+			pb.Value = &metricspb.NumberDataPoint_AsInt{AsInt: *dp.AsInt}
+		case dp.AsDouble != nil:
+			pb.Value = &metricspb.NumberDataPoint_AsDouble{AsDouble: *dp.AsDouble}
+		}
+		out = append(out, pb)
+	}
+	return out
+}
+
+func histogramPointsToProto(points []HistogramDataPoint) []*metricspb.HistogramDataPoint {
+	out := make([]*metricspb.HistogramDataPoint, 0, len(points))
+	for i := range points {
+		dp := &points[i]
+		out = append(out, &metricspb.HistogramDataPoint{
+			Attributes:        attributesToProto(dp.Attributes),
+			StartTimeUnixNano: parseNano(dp.StartTimeUnixNano),
+			TimeUnixNano:      parseNano(dp.TimeUnixNano),
+			Count:             dp.Count,
+			Sum:               dp.Sum,
+			BucketCounts:      dp.BucketCounts,
+			ExplicitBounds:    dp.ExplicitBounds,
+			Exemplars:         exemplarsToProto(dp.Exemplars),
+			Flags:             dp.Flags,
+			Min:               dp.Min,
+			Max:               dp.Max,
+		})
+	}
+	return out
+}
+
+func exponentialPointsToProto(points []ExponentialHistogramDataPoint) []*metricspb.ExponentialHistogramDataPoint {
+	out := make([]*metricspb.ExponentialHistogramDataPoint, 0, len(points))
+	for i := range points {
+		dp := &points[i]
+		out = append(out, &metricspb.ExponentialHistogramDataPoint{
+			Attributes:        attributesToProto(dp.Attributes),
+			StartTimeUnixNano: parseNano(dp.StartTimeUnixNano),
+			TimeUnixNano:      parseNano(dp.TimeUnixNano),
+			Count:             dp.Count,
+			Sum:               dp.Sum,
+			Scale:             dp.Scale,
+			ZeroCount:         dp.ZeroCount,
+			Positive:          bucketsToProto(dp.Positive),
+			Negative:          bucketsToProto(dp.Negative),
+			Flags:             dp.Flags,
+			Exemplars:         exemplarsToProto(dp.Exemplars),
+			Min:               dp.Min,
+			Max:               dp.Max,
+			ZeroThreshold:     dp.ZeroThreshold,
+		})
+	}
+	return out
+}
+
+func bucketsToProto(b *ExponentialBuckets) *metricspb.ExponentialHistogramDataPoint_Buckets {
+	if b == nil {
+		return nil
+	}
+	return &metricspb.ExponentialHistogramDataPoint_Buckets{Offset: b.Offset, BucketCounts: b.BucketCounts}
+}
+
+func exemplarsToProto(exemplars []Exemplar) []*metricspb.Exemplar {
+	if len(exemplars) == 0 {
+		return nil
+	}
+	out := make([]*metricspb.Exemplar, 0, len(exemplars))
+	for i := range exemplars {
+		e := &exemplars[i]
+		pb := &metricspb.Exemplar{
+			FilteredAttributes: attributesToProto(e.FilteredAttributes),
+			TimeUnixNano:       parseNano(e.TimeUnixNano),
+			SpanId:             decodeID(e.SpanID),
+			TraceId:            decodeID(e.TraceID),
+		}
+		switch {
+		case e.AsInt != nil:
+			pb.Value = &metricspb.Exemplar_AsInt{AsInt: *e.AsInt}
+		case e.AsDouble != nil:
+			pb.Value = &metricspb.Exemplar_AsDouble{AsDouble: *e.AsDouble}
+		}
+		out = append(out, pb)
+	}
+	return out
+}
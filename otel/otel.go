@@ -1,8 +1,55 @@
 package otel
 
-// OTelPayload models the OTLP JSON structure for traces.
+// OTelPayload models the OTLP JSON structure. Payloads moving through the
+// pipeline carry a single signal, so marshalled they are the OTLP/JSON export
+// request of that signal.
 type OTelPayload struct {
-	ResourceSpans []ResourceSpan `json:"resourceSpans"`
+	ResourceSpans   []ResourceSpan    `json:"resourceSpans,omitempty"`
+	ResourceLogs    []ResourceLogs    `json:"resourceLogs,omitempty"`
+	ResourceMetrics []ResourceMetrics `json:"resourceMetrics,omitempty"`
+}
+
+// Signals, see OTelPayload.Signal.
+const (
+	SignalTraces  = "traces"
+	SignalLogs    = "logs"
+	SignalMetrics = "metrics"
+)
+
+// Signal names the signal the payload carries.
+func (p OTelPayload) Signal() string {
+	switch {
+	case len(p.ResourceLogs) > 0:
+		return SignalLogs
+	case len(p.ResourceMetrics) > 0:
+		return SignalMetrics
+	}
+	return SignalTraces
+}
+
+// FirstResource returns the resource of the first resource entry, whatever
+// the signal.
+func (p OTelPayload) FirstResource() (Resource, bool) {
+	switch {
+	case len(p.ResourceSpans) > 0:
+		return p.ResourceSpans[0].Resource, true
+	case len(p.ResourceLogs) > 0:
+		return p.ResourceLogs[0].Resource, true
+	case len(p.ResourceMetrics) > 0:
+		return p.ResourceMetrics[0].Resource, true
+	}
+	return Resource{}, false
+}
+
+// SpanCount returns the number of spans in the payload.
+func (p OTelPayload) SpanCount() int {
+	n := 0
+	for i := range p.ResourceSpans {
+		for j := range p.ResourceSpans[i].ScopeSpans {
+			n += len(p.ResourceSpans[i].ScopeSpans[j].Spans)
+		}
+	}
+	return n
 }
 
 type ResourceSpan struct {
@@ -14,6 +61,16 @@ type Resource struct {
 	Attributes []Attribute `json:"attributes"`
 }
 
+// StringAttribute returns the string value of the attribute key, or "".
+func (r Resource) StringAttribute(key string) string {
+	for _, a := range r.Attributes {
+		if a.Key == key {
+			return a.Value.StringValue
+		}
+	}
+	return ""
+}
+
 type ScopeSpans struct {
 	Spans []OTelSpan `json:"spans"`
 }
@@ -28,6 +85,7 @@ type OTelSpan struct {
 	EndTimeUnixNano   string      `json:"endTimeUnixNano"`
 	Attributes        []Attribute `json:"attributes"`
 	Events            []Event     `json:"events,omitempty"`
+	Links             []Link      `json:"links,omitempty"`
 	Status            *Status     `json:"status,omitempty"`
 }
 
@@ -53,13 +111,23 @@ type Event struct {
 	Attributes   []Attribute `json:"attributes"`
 }
 
+// Link points from a span to a span it is causally related to but which is not
+// its parent, e.g. further producers of a batch consumed together.
+type Link struct {
+	TraceID    string      `json:"traceId"`
+	SpanID     string      `json:"spanId"`
+	Attributes []Attribute `json:"attributes,omitempty"`
+}
+
+// MapSpanTypeToKind accepts the SkyWalking span type either as its enum number
+// or as its name (as sent by JSON agents and produced from gRPC segments).
 func MapSpanTypeToKind(spanType string) string {
 	switch spanType {
-	case "0":
+	case "0", "Entry":
 		return "SPAN_KIND_SERVER"
-	case "1":
+	case "1", "Exit":
 		return "SPAN_KIND_CLIENT"
-	case "2":
+	case "2", "Local":
 		return "SPAN_KIND_INTERNAL"
 	default:
 		return "SPAN_KIND_INTERNAL"
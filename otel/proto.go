@@ -0,0 +1,119 @@
+package otel
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// ToProto converts the payload into the OTLP protobuf export request used by
+// the gRPC and HTTP/protobuf exporters.
+func (p OTelPayload) ToProto() *coltracepb.ExportTraceServiceRequest {
+	req := &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: make([]*tracepb.ResourceSpans, 0, len(p.ResourceSpans)),
+	}
+	for i := range p.ResourceSpans {
+		rs := &p.ResourceSpans[i]
+		out := &tracepb.ResourceSpans{
+			Resource:   resourceToProto(rs.Resource),
+			ScopeSpans: make([]*tracepb.ScopeSpans, 0, len(rs.ScopeSpans)),
+		}
+		for j := range rs.ScopeSpans {
+			ss := &rs.ScopeSpans[j]
+			spans := make([]*tracepb.Span, 0, len(ss.Spans))
+			for k := range ss.Spans {
+				spans = append(spans, spanToProto(&ss.Spans[k]))
+			}
+			out.ScopeSpans = append(out.ScopeSpans, &tracepb.ScopeSpans{Spans: spans})
+		}
+		req.ResourceSpans = append(req.ResourceSpans, out)
+	}
+	return req
+}
+
+func spanToProto(s *OTelSpan) *tracepb.Span {
+	out := &tracepb.Span{
+		TraceId:           decodeID(s.TraceID),
+		SpanId:            decodeID(s.SpanID),
+		ParentSpanId:      decodeID(s.ParentSpanID),
+		Name:              s.Name,
+		Kind:              tracepb.Span_SpanKind(tracepb.Span_SpanKind_value[s.Kind]),
+		StartTimeUnixNano: parseNano(s.StartTimeUnixNano),
+		EndTimeUnixNano:   parseNano(s.EndTimeUnixNano),
+		Attributes:        attributesToProto(s.Attributes),
+	}
+	for i := range s.Events {
+		e := &s.Events[i]
+		out.Events = append(out.Events, &tracepb.Span_Event{
+			Name:         e.Name,
+			TimeUnixNano: parseNano(e.TimeUnixNano),
+			Attributes:   attributesToProto(e.Attributes),
+		})
+	}
+	for i := range s.Links {
+		l := &s.Links[i]
+		out.Links = append(out.Links, &tracepb.Span_Link{
+			TraceId:    decodeID(l.TraceID),
+			SpanId:     decodeID(l.SpanID),
+			Attributes: attributesToProto(l.Attributes),
+		})
+	}
+	if s.Status != nil {
+		out.Status = &tracepb.Status{
+			Code:    tracepb.Status_StatusCode(tracepb.Status_StatusCode_value[s.Status.Code]),
+			Message: s.Status.Message,
+		}
+	}
+	return out
+}
+
+func resourceToProto(r Resource) *resourcepb.Resource {
+	return &resourcepb.Resource{Attributes: attributesToProto(r.Attributes)}
+}
+
+func attributesToProto(attrs []Attribute) []*commonpb.KeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]*commonpb.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		out = append(out, &commonpb.KeyValue{Key: a.Key, Value: a.Value.toProto()})
+	}
+	return out
+}
+
+// toProto picks the populated field. The JSON model cannot tell a false bool
+// or a zero int from an empty string, so those end up as empty strings, just
+// as they read in OTLP/JSON.
+func (v AttributeVal) toProto() *commonpb.AnyValue {
+	switch {
+	case v.BoolValue:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: true}}
+	case v.IntValue != 0:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v.IntValue}}
+	default:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.StringValue}}
+	}
+}
+
+// decodeID turns a hex trace/span ID into bytes; empty or malformed IDs become
+// nil, which OTLP treats as unset.
+func decodeID(id string) []byte {
+	if id == "" {
+		return nil
+	}
+	b, err := hex.DecodeString(id)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+func parseNano(s string) uint64 {
+	n, _ := strconv.ParseUint(s, 10, 64)
+	return n
+}
@@ -0,0 +1,571 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"skywalking_transformer/converter"
+	"skywalking_transformer/exporter"
+	"skywalking_transformer/metrics"
+	"skywalking_transformer/otel"
+	"skywalking_transformer/queue"
+)
+
+// ----------- Exporter config -----------
+
+// defaultExporter is configured by the unprefixed CODEXRAY_COLLECTOR_* and
+// CODEXRAY_EXPORTER_* variables; its disk queue and dead letter files stay
+// directly in CODEXRAY_QUEUE_DIR and CODEXRAY_DEAD_LETTER_DIR.
+const defaultExporter = "default"
+
+var exporterNameRe = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+type exporterConfig struct {
+	name          string
+	protocol      string
+	url           string
+	grpcEndpoint  string
+	grpcInsecure  bool
+	compression   string
+	compressLevel int
+	headers       map[string]string
+	tenantHeader  string
+	logsURL       string
+	metricsURL    string
+}
+
+// loadExporterConfigs reads CODEXRAY_EXPORTERS, a comma separated list of
+// exporter names. Every name other than "default" is configured through
+// CODEXRAY_EXPORTER_<NAME>_* and falls back to the default exporter's
+// protocol, compression and tenant header settings, but not its headers.
+func loadExporterConfigs() ([]exporterConfig, error) {
+	names := os.Getenv("CODEXRAY_EXPORTERS")
+	if names == "" {
+		names = defaultExporter
+	}
+	var out []exporterConfig
+	seen := map[string]bool{}
+	for _, name := range strings.Split(names, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if !exporterNameRe.MatchString(name) {
+			return nil, fmt.Errorf("invalid exporter name %q (want [a-z0-9_]+)", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("exporter %q listed twice", name)
+		}
+		seen[name] = true
+		cfg := exporterConfig{
+			name:          name,
+			protocol:      exportProtocol,
+			url:           collectorURL,
+			grpcEndpoint:  grpcEndpoint,
+			grpcInsecure:  grpcInsecure,
+			compression:   compression,
+			compressLevel: compressLevel,
+			headers:       getenvMap("CODEXRAY_EXPORTER_HEADERS"),
+			tenantHeader:  tenantHeader,
+			logsURL:       os.Getenv("CODEXRAY_COLLECTOR_LOGS_URL"),
+			metricsURL:    os.Getenv("CODEXRAY_COLLECTOR_METRICS_URL"),
+		}
+		if name != defaultExporter {
+			prefix := "CODEXRAY_EXPORTER_" + strings.ToUpper(name) + "_"
+			if v := os.Getenv(prefix + "PROTOCOL"); v != "" {
+				cfg.protocol = v
+			}
+			cfg.url = os.Getenv(prefix + "URL")
+			cfg.grpcEndpoint = os.Getenv(prefix + "GRPC_ENDPOINT")
+			cfg.grpcInsecure = getenvBool(prefix+"GRPC_INSECURE", grpcInsecure)
+			if v := os.Getenv(prefix + "COMPRESSION"); v != "" {
+				cfg.compression = v
+			}
+			cfg.compressLevel = getenvInt(prefix+"COMPRESSION_LEVEL", compressLevel)
+			cfg.headers = getenvMap(prefix + "HEADERS")
+			cfg.logsURL = os.Getenv(prefix + "LOGS_URL")
+			cfg.metricsURL = os.Getenv(prefix + "METRICS_URL")
+			if v, ok := os.LookupEnv(prefix + "TENANT_HEADER"); ok {
+				cfg.tenantHeader = v
+			}
+			if cfg.protocol == exporter.ProtocolGRPC && cfg.grpcEndpoint == "" {
+				return nil, fmt.Errorf("exporter %q: %sGRPC_ENDPOINT is required", name, prefix)
+			}
+			if cfg.protocol != exporter.ProtocolGRPC && cfg.url == "" {
+				return nil, fmt.Errorf("exporter %q: %sURL is required", name, prefix)
+			}
+		}
+		out = append(out, cfg)
+	}
+	if len(out) == 0 {
+		return nil, errors.New("CODEXRAY_EXPORTERS lists no exporter")
+	}
+	return out, nil
+}
+
+func (cfg exporterConfig) endpoint() string {
+	if cfg.protocol == exporter.ProtocolGRPC {
+		return cfg.grpcEndpoint
+	}
+	return cfg.url
+}
+
+func makeExporter(cfg exporterConfig) (exporter.Exporter, error) {
+	switch cfg.protocol {
+	case exporter.ProtocolHTTPJSON, exporter.ProtocolHTTPProtobuf:
+		return exporter.NewHTTP(cfg.url, httpClient, exporter.HTTPConfig{
+			Protocol:         cfg.protocol,
+			Compression:      cfg.compression,
+			CompressionLevel: cfg.compressLevel,
+			Headers:          cfg.headers,
+			LogsURL:          cfg.logsURL,
+			MetricsURL:       cfg.metricsURL,
+		})
+	case exporter.ProtocolGRPC:
+		return exporter.NewGRPC(cfg.grpcEndpoint, exporter.GRPCConfig{
+			Insecure:         cfg.grpcInsecure,
+			KeepaliveTime:    grpcKeepalive,
+			KeepaliveTimeout: httpTimeout,
+			Compression:      cfg.compression,
+			CompressionLevel: cfg.compressLevel,
+			Headers:          cfg.headers,
+		})
+	default:
+		return nil, fmt.Errorf("unknown exporter protocol %q", cfg.protocol)
+	}
+}
+
+// ----------- Pipeline (one per exporter) -----------
+
+// pipeline owns everything between the fan-out and one destination: its own
+// job queue (in memory or on disk), batcher, sender workers, retry budget,
+// circuit breaker and dead letter directory, so a slow or failing exporter
+// does not hold back the others.
+type pipeline struct {
+	name         string
+	tenantHeader string // "" when exports carry no tenant
+	exporter     exporter.Exporter
+	breaker      *exporter.Breaker // nil when disabled
+	jobCh        chan job
+	combinedCh   chan combined
+	diskQueue    *queue.Queue      // nil unless CODEXRAY_QUEUE_DIR is set
+	deadLetter   *queue.DeadLetter // nil unless CODEXRAY_DEAD_LETTER_DIR is set
+}
+
+var pipelines []*pipeline
+
+func newPipeline(cfg exporterConfig) (*pipeline, error) {
+	exp, err := makeExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	p := &pipeline{
+		name:         cfg.name,
+		tenantHeader: cfg.tenantHeader,
+		jobCh:        make(chan job, queueSize),
+		combinedCh:   make(chan combined, workerCount*2),
+	}
+	if breakerEnabled {
+		p.breaker = exporter.NewBreaker(breakerConfig)
+		exp = exporter.WithBreaker(exp, p.breaker)
+	}
+	p.exporter = exp
+
+	if queueDir != "" {
+		q, err := queue.Open(queue.Options{
+			Dir:             p.dir(queueDir),
+			SegmentBytes:    queueSegmentSize,
+			MaxBytes:        queueMaxDisk,
+			SyncEveryAppend: queueFsync,
+			OnEvict: func(records int) {
+				metrics.Dropped.WithLabelValues(p.name, "disk_evicted").Add(float64(records))
+			},
+		})
+		if err != nil {
+			_ = exp.Close()
+			return nil, fmt.Errorf("disk queue: %w", err)
+		}
+		p.diskQueue = q
+	}
+	if deadLetterDir != "" {
+		dl, err := queue.OpenDeadLetter(p.dir(deadLetterDir))
+		if err != nil {
+			p.close()
+			return nil, fmt.Errorf("dead letter: %w", err)
+		}
+		p.deadLetter = dl
+	}
+	p.registerGauges()
+	return p, nil
+}
+
+// dir is the exporter's own subdirectory of base; the default exporter uses
+// base itself so existing queues survive an upgrade.
+func (p *pipeline) dir(base string) string {
+	if p.name == defaultExporter {
+		return base
+	}
+	return filepath.Join(base, p.name)
+}
+
+func (p *pipeline) registerGauges() {
+	labels := prometheus.Labels{"exporter": p.name}
+	metrics.Gauge("job_queue_depth", "Converted payloads waiting for the batcher.", labels, func() float64 {
+		return float64(len(p.jobCh))
+	})
+	metrics.Gauge("combined_queue_depth", "Batches waiting for a sender worker.", labels, func() float64 {
+		return float64(len(p.combinedCh))
+	})
+	metrics.Gauge("circuit_breaker_state", "Export circuit breaker: 0 closed, 1 open, 2 half-open.", labels, func() float64 {
+		if p.breaker == nil {
+			return 0
+		}
+		return float64(p.breaker.State())
+	})
+	if p.diskQueue != nil {
+		metrics.Gauge("disk_queue_bytes", "Bytes held by the disk queue.", labels, func() float64 {
+			return float64(p.diskQueue.Size())
+		})
+	}
+}
+
+// start launches the queue reader (with a disk queue), the batcher and the
+// sender workers.
+func (p *pipeline) start(ctx context.Context, wg *sync.WaitGroup) {
+	if p.diskQueue != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.runQueueReader(ctx)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		p.runBatcher()
+	}()
+	for i := 0; i < workerCount; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			p.runSender(ctx, id)
+		}(i + 1)
+	}
+}
+
+// stopIntake closes jobCh so the batcher flushes and exits. With a disk queue
+// the queue reader owns jobCh and closes it itself.
+func (p *pipeline) stopIntake() {
+	if p.diskQueue == nil {
+		close(p.jobCh)
+	}
+}
+
+// close releases the disk queue and the exporter once the workers are done.
+func (p *pipeline) close() {
+	if p.diskQueue != nil {
+		if err := p.diskQueue.Close(); err != nil {
+			log.Printf("[%s] disk queue close error: %v", p.name, err)
+		}
+	}
+	if err := p.exporter.Close(); err != nil {
+		log.Printf("[%s] exporter close error: %v", p.name, err)
+	}
+}
+
+// enqueue queues a converted payload for this exporter, see enqueueSegment.
+// In block and reject mode it waits for room until the request's deadline
+// (a zero deadline waits without limit); then block mode drops the payload
+// and reject mode rejects it. A payload mixing tenants is queued as one job
+// per tenant.
+func (p *pipeline) enqueue(otelPayload otel.OTelPayload, deadline time.Time) bool {
+	ok := true
+	for _, j := range p.tenantJobs(otelPayload) {
+		if !p.enqueueJob(j, deadline) {
+			ok = false
+		}
+	}
+	return ok
+}
+
+func (p *pipeline) enqueueJob(j job, deadline time.Time) bool {
+	if p.diskQueue != nil {
+		return p.appendToDiskQueue(j.payload)
+	}
+	switch queueFullMode {
+	case queueFullDrop:
+		select {
+		case p.jobCh <- j:
+			return true
+		default:
+			log.Printf("[%s] queue full, dropping payload", p.name)
+			metrics.Dropped.WithLabelValues(p.name, "queue_full").Inc()
+			return false
+		}
+	default:
+		if deadline.IsZero() {
+			p.jobCh <- j
+			return true
+		}
+		select {
+		case p.jobCh <- j:
+			return true
+		default:
+		}
+		wait := time.Until(deadline)
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			defer timer.Stop()
+			select {
+			case p.jobCh <- j:
+				return true
+			case <-timer.C:
+			}
+		}
+		if queueFullMode == queueFullReject {
+			metrics.Dropped.WithLabelValues(p.name, "rejected").Inc()
+			return false
+		}
+		log.Printf("[%s] queue full until the request deadline, dropping payload", p.name)
+		metrics.Dropped.WithLabelValues(p.name, "queue_full").Inc()
+		return false
+	}
+}
+
+// tenant is the value of the pipeline's tenant header for a payload: the
+// team ID the converter put on the resource, else CODEXRAY_TENANT_DEFAULT.
+// Jobs hold a single tenant (see tenantJobs), so the first resource decides.
+func (p *pipeline) tenant(payload otel.OTelPayload) string {
+	res, _ := payload.FirstResource()
+	return p.resourceTenant(res)
+}
+
+func (p *pipeline) resourceTenant(res otel.Resource) string {
+	if p.tenantHeader == "" {
+		return ""
+	}
+	if t := res.StringAttribute(converter.TeamIDAttribute); t != "" {
+		return t
+	}
+	return tenantDefault
+}
+
+// tenantJobs splits a payload into one job per tenant, in order of first
+// appearance. Converted payloads come from a single service, but a batch
+// must never carry two tenants whatever the producer hands in.
+func (p *pipeline) tenantJobs(payload otel.OTelPayload) []job {
+	if p.tenantHeader == "" {
+		return []job{{payload: payload}}
+	}
+	var jobs []job
+	index := map[string]int{}
+	part := func(res otel.Resource) *otel.OTelPayload {
+		t := p.resourceTenant(res)
+		i, ok := index[t]
+		if !ok {
+			i = len(jobs)
+			index[t] = i
+			jobs = append(jobs, job{tenant: t})
+		}
+		return &jobs[i].payload
+	}
+	for _, rs := range payload.ResourceSpans {
+		out := part(rs.Resource)
+		out.ResourceSpans = append(out.ResourceSpans, rs)
+	}
+	for _, rl := range payload.ResourceLogs {
+		out := part(rl.Resource)
+		out.ResourceLogs = append(out.ResourceLogs, rl)
+	}
+	for _, rm := range payload.ResourceMetrics {
+		out := part(rm.Resource)
+		out.ResourceMetrics = append(out.ResourceMetrics, rm)
+	}
+	if len(jobs) == 0 {
+		return []job{{payload: payload, tenant: tenantDefault}}
+	}
+	return jobs
+}
+
+// ----------- Batcher & Sender -----------
+
+// runBatcher merges jobs into batches, one per signal and tenant, so spans of
+// different tenants never share an export request. It runs until jobCh is
+// closed and flushes everything it holds before closing combinedCh.
+func (p *pipeline) runBatcher() {
+	ticker := time.NewTicker(batchFlush)
+	defer ticker.Stop()
+	type batchKey struct{ signal, tenant string }
+	type batch struct {
+		payloads []otel.OTelPayload
+		acks     []func()
+	}
+	bufs := map[batchKey]*batch{}
+	flush := func(k batchKey) {
+		b := bufs[k]
+		if b == nil {
+			return
+		}
+		delete(bufs, k)
+		merged := mergePayloads(b.payloads)
+		if k.signal == otel.SignalTraces {
+			metrics.BatchSpans.WithLabelValues(p.name).Observe(float64(merged.SpanCount()))
+		}
+		p.combinedCh <- combined{payload: merged, tenant: k.tenant, acks: b.acks}
+	}
+	flushAll := func() {
+		for k := range bufs {
+			flush(k)
+		}
+	}
+
+	for {
+		select {
+		case j, ok := <-p.jobCh:
+			if !ok {
+				flushAll()
+				close(p.combinedCh)
+				return
+			}
+			k := batchKey{signal: j.payload.Signal(), tenant: j.tenant}
+			b := bufs[k]
+			if b == nil {
+				b = &batch{}
+				bufs[k] = b
+			}
+			b.payloads = append(b.payloads, j.payload)
+			if j.ack != nil {
+				b.acks = append(b.acks, j.ack)
+			}
+			if len(b.payloads) >= batchSize {
+				flush(k)
+			}
+		case <-ticker.C:
+			flushAll()
+		}
+	}
+}
+
+// runSender exports batches until the batcher closes combinedCh. Once ctx is
+// cancelled the remaining batches get one attempt each, without retries.
+func (p *pipeline) runSender(ctx context.Context, id int) {
+	for cmb := range p.combinedCh {
+		if err := p.exportWithRetry(ctx, id, cmb.payload, cmb.tenant); err != nil {
+			if !p.deadLetterBatch(cmb.payload) && exporter.Retryable(err) {
+				// unacknowledged disk queue records are replayed on restart
+				continue
+			}
+		}
+		for _, ack := range cmb.acks {
+			ack()
+		}
+	}
+}
+
+// exportWithRetry sends a batch, retrying transient failures with exponential
+// backoff (or the collector's Retry-After, if longer) until the policy's
+// attempt or elapsed-time budget runs out. While the circuit is open the
+// batch waits for it to let probes through; those rejections do not count as
+// attempts, since the collector was not called. Shutdown stops the retries.
+func (p *pipeline) exportWithRetry(ctx context.Context, id int, payload otel.OTelPayload, tenant string) error {
+	start := time.Now()
+	for attempt := 1; ; {
+		err := p.send(payload, tenant)
+		if err == nil {
+			return nil
+		}
+		var wait time.Duration
+		if errors.Is(err, exporter.ErrCircuitOpen) {
+			wait = p.breaker.HalfOpenIn()
+			if wait <= 0 {
+				// half-open, with all probes already out
+				wait = retryPolicy.Backoff(1)
+			}
+		} else {
+			if !exporter.Retryable(err) {
+				log.Printf("[%s worker %d] permanent send error: %v", p.name, id, err)
+				return err
+			}
+			if attempt >= retryPolicy.MaxAttempts {
+				log.Printf("[%s worker %d] giving up after %d attempts: %v", p.name, id, attempt, err)
+				return err
+			}
+			wait = retryPolicy.Backoff(attempt)
+			if ra := exporter.RetryAfter(err); ra > wait {
+				wait = ra
+			}
+		}
+		if time.Since(start)+wait > retryPolicy.MaxElapsed {
+			log.Printf("[%s worker %d] giving up after %s: %v", p.name, id, time.Since(start).Round(time.Millisecond), err)
+			return err
+		}
+		if errors.Is(err, exporter.ErrCircuitOpen) {
+			log.Printf("[%s worker %d] circuit open, retry in %s", p.name, id, wait.Round(time.Millisecond))
+		} else {
+			log.Printf("[%s worker %d] send error (attempt %d, retry in %s): %v", p.name, id, attempt, wait.Round(time.Millisecond), err)
+			attempt++
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return err
+		}
+		metrics.ExportRetries.WithLabelValues(p.name).Inc()
+	}
+}
+
+func (p *pipeline) send(payload otel.OTelPayload, tenant string) error {
+	// not tied to the pipeline context: batches in flight at shutdown still go out
+	ctx, cancel := context.WithTimeout(context.Background(), httpTimeout)
+	defer cancel()
+	if tenant != "" {
+		ctx = exporter.ContextWithHeaders(ctx, map[string]string{p.tenantHeader: tenant})
+	}
+	start := time.Now()
+	var err error
+	switch payload.Signal() {
+	case otel.SignalLogs:
+		err = p.exporter.ExportLogs(ctx, payload)
+	case otel.SignalMetrics:
+		err = p.exporter.ExportMetrics(ctx, payload)
+	default:
+		err = p.exporter.ExportTraces(ctx, payload)
+	}
+	if !errors.Is(err, exporter.ErrCircuitOpen) {
+		metrics.ExportDuration.WithLabelValues(p.name).Observe(time.Since(start).Seconds())
+	}
+	metrics.ExportResults.WithLabelValues(p.name, exporter.Outcome(err)).Inc()
+	return err
+}
+
+func mergePayloads(items []otel.OTelPayload) otel.OTelPayload {
+	var out otel.OTelPayload
+	for _, it := range items {
+		out.ResourceSpans = append(out.ResourceSpans, it.ResourceSpans...)
+		out.ResourceLogs = append(out.ResourceLogs, it.ResourceLogs...)
+		out.ResourceMetrics = append(out.ResourceMetrics, it.ResourceMetrics...)
+	}
+	return out
+}
+
+// describe summarizes a batch for log messages.
+func describe(payload otel.OTelPayload) string {
+	switch payload.Signal() {
+	case otel.SignalLogs:
+		return fmt.Sprintf("%d log records", payload.LogRecordCount())
+	case otel.SignalMetrics:
+		return fmt.Sprintf("%d data points", payload.DataPointCount())
+	default:
+		return fmt.Sprintf("%d spans", payload.SpanCount())
+	}
+}
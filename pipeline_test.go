@@ -0,0 +1,116 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"skywalking_transformer/converter"
+	"skywalking_transformer/otel"
+)
+
+func teamResource(team string) otel.Resource {
+	return otel.Resource{Attributes: []otel.Attribute{{
+		Key:   converter.TeamIDAttribute,
+		Value: otel.AttributeVal{StringValue: team},
+	}}}
+}
+
+func spansFor(teams ...string) otel.OTelPayload {
+	var p otel.OTelPayload
+	for _, team := range teams {
+		p.ResourceSpans = append(p.ResourceSpans, otel.ResourceSpan{Resource: teamResource(team)})
+	}
+	return p
+}
+
+func logsFor(team string) otel.OTelPayload {
+	return otel.OTelPayload{ResourceLogs: []otel.ResourceLogs{{Resource: teamResource(team)}}}
+}
+
+func newTenantPipeline() *pipeline {
+	return &pipeline{
+		name:         "test",
+		tenantHeader: "X-Scope-OrgID",
+		jobCh:        make(chan job, 16),
+		combinedCh:   make(chan combined, 16),
+	}
+}
+
+func TestTenantJobsSplitsMixedPayloads(t *testing.T) {
+	p := newTenantPipeline()
+	jobs := p.tenantJobs(spansFor("acme", "", "globex", "acme"))
+	want := []struct {
+		tenant    string
+		resources int
+	}{
+		{"acme", 2},
+		{tenantDefault, 1},
+		{"globex", 1},
+	}
+	if len(jobs) != len(want) {
+		t.Fatalf("got %d jobs, want %d", len(jobs), len(want))
+	}
+	for i, w := range want {
+		if jobs[i].tenant != w.tenant || len(jobs[i].payload.ResourceSpans) != w.resources {
+			t.Errorf("job %d = tenant %q with %d resources, want %q with %d",
+				i, jobs[i].tenant, len(jobs[i].payload.ResourceSpans), w.tenant, w.resources)
+		}
+	}
+
+	p.tenantHeader = ""
+	if jobs := p.tenantJobs(spansFor("acme", "globex")); len(jobs) != 1 || jobs[0].tenant != "" {
+		t.Errorf("without a tenant header got %d jobs (tenant %q), want one without tenant", len(jobs), jobs[0].tenant)
+	}
+}
+
+func TestBatcherKeepsTenantsApart(t *testing.T) {
+	savedSize, savedFlush := batchSize, batchFlush
+	t.Cleanup(func() { batchSize, batchFlush = savedSize, savedFlush })
+	batchSize, batchFlush = 100, time.Hour
+
+	p := newTenantPipeline()
+	for _, payload := range []otel.OTelPayload{
+		spansFor("acme"),
+		spansFor("globex"),
+		spansFor("acme", "globex"), // mixed
+		logsFor("acme"),
+		spansFor("acme"),
+	} {
+		if !p.enqueue(payload, time.Time{}) {
+			t.Fatal("enqueue failed")
+		}
+	}
+	close(p.jobCh)
+	p.runBatcher()
+
+	type key struct{ signal, tenant string }
+	got := map[key]int{}
+	for cmb := range p.combinedCh {
+		var resources []otel.Resource
+		for _, rs := range cmb.payload.ResourceSpans {
+			resources = append(resources, rs.Resource)
+		}
+		for _, rl := range cmb.payload.ResourceLogs {
+			resources = append(resources, rl.Resource)
+		}
+		for _, res := range resources {
+			if team := res.StringAttribute(converter.TeamIDAttribute); team != cmb.tenant {
+				t.Errorf("batch for tenant %q carries a resource of %q", cmb.tenant, team)
+			}
+		}
+		got[key{cmb.payload.Signal(), cmb.tenant}] += len(resources)
+	}
+	want := map[key]int{
+		{otel.SignalTraces, "acme"}:   3,
+		{otel.SignalTraces, "globex"}: 2,
+		{otel.SignalLogs, "acme"}:     1,
+	}
+	if len(got) != len(want) {
+		t.Errorf("batches = %v, want %v", got, want)
+	}
+	for k, n := range want {
+		if got[k] != n {
+			t.Errorf("batch %v has %d resources, want %d", k, got[k], n)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package queue
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	deadLetterExt    = ".jsonl"
+	deadLetterReplay = ".replaying"
+)
+
+// maxDeadLetterLine caps the lines Replay reads; a variable for tests.
+var maxDeadLetterLine = maxRecordSize
+
+// DeadLetter stores batches that could not be delivered as JSON lines, one
+// file per day. Each line is a complete OTLP/JSON request, so a file can be
+// replayed through Replay or posted line by line to a collector by hand.
+type DeadLetter struct {
+	dir      string
+	mu       sync.Mutex // guards the files
+	replayMu sync.Mutex // one Replay at a time, or both would send leftovers
+}
+
+func OpenDeadLetter(dir string) (*DeadLetter, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	return &DeadLetter{dir: dir}, nil
+}
+
+// Write appends one batch. line must not contain newlines (json.Marshal
+// output never does).
+func (d *DeadLetter) Write(line []byte) error {
+	if bytes.IndexByte(line, '\n') >= 0 {
+		return errors.New("dead letter record contains a newline")
+	}
+	name := filepath.Join(d.dir, "deadletter-"+time.Now().UTC().Format("20060102")+deadLetterExt)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Replay hands every stored batch to fn, oldest file first, and deletes each
+// file once all of its lines were accepted. A file is renamed before reading
+// so batches failing during the replay land in a fresh file. If fn fails the
+// rest of that file is kept for the next replay, as is everything from a line
+// too long to read.
+func (d *DeadLetter) Replay(fn func(line []byte) error) (int, error) {
+	d.replayMu.Lock()
+	defer d.replayMu.Unlock()
+	d.mu.Lock()
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		d.mu.Unlock()
+		return 0, err
+	}
+	var files []string
+	for _, e := range entries {
+		name := e.Name()
+		switch {
+		case strings.HasSuffix(name, deadLetterReplay):
+			// left over from an interrupted replay
+			files = append(files, filepath.Join(d.dir, name))
+		case strings.HasSuffix(name, deadLetterExt):
+			src := filepath.Join(d.dir, name)
+			dst := src + deadLetterReplay
+			if err := os.Rename(src, dst); err != nil {
+				d.mu.Unlock()
+				return 0, err
+			}
+			files = append(files, dst)
+		}
+	}
+	d.mu.Unlock()
+	sort.Strings(files)
+
+	replayed := 0
+	for _, path := range files {
+		n, err := d.replayFile(path, fn)
+		replayed += n
+		if err != nil {
+			return replayed, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+	}
+	return replayed, nil
+}
+
+func (d *DeadLetter) replayFile(path string, fn func([]byte) error) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, min(1<<20, maxDeadLetterLine)), maxDeadLetterLine)
+	var offset int64 // end of the last line read
+	sc.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := bufio.ScanLines(data, atEOF)
+		offset += int64(advance)
+		return advance, token, err
+	})
+	var rest [][]byte
+	n := 0
+	var fnErr error
+	for sc.Scan() {
+		line := sc.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		if fnErr == nil {
+			if fnErr = fn(line); fnErr == nil {
+				n++
+				continue
+			}
+		}
+		rest = append(rest, append([]byte(nil), line...))
+	}
+	scanErr := sc.Err()
+	var tail []byte
+	if scanErr != nil {
+		// the scanner stopped at a line it cannot read; nothing from there on
+		// was replayed
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			_ = f.Close()
+			return n, errors.Join(scanErr, err)
+		}
+		if tail, err = io.ReadAll(f); err != nil {
+			_ = f.Close()
+			return n, errors.Join(scanErr, err)
+		}
+	}
+	_ = f.Close()
+	if scanErr == nil && fnErr == nil {
+		return n, os.Remove(path)
+	}
+	// keep what was not replayed, so the next replay does not send the rest
+	// twice
+	var keep []byte
+	if len(rest) > 0 {
+		keep = append(bytes.Join(rest, []byte{'\n'}), '\n')
+	}
+	keep = append(keep, tail...)
+	return n, errors.Join(scanErr, fnErr, os.WriteFile(path, keep, 0o640))
+}
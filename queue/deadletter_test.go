@@ -0,0 +1,147 @@
+package queue
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReplayDeletesDeliveredFiles(t *testing.T) {
+	dir := t.TempDir()
+	d, err := OpenDeadLetter(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := d.Write([]byte(fmt.Sprintf(`{"n":%d}`, i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var got []string
+	n, err := d.Replay(func(line []byte) error {
+		got = append(got, string(line))
+		return nil
+	})
+	if err != nil || n != 3 || len(got) != 3 {
+		t.Fatalf("Replay = %d, %v; got %v", n, err, got)
+	}
+	if left, _ := os.ReadDir(dir); len(left) != 0 {
+		t.Errorf("%d files left after a full replay", len(left))
+	}
+}
+
+func TestReplayKeepsFailedLines(t *testing.T) {
+	dir := t.TempDir()
+	d, err := OpenDeadLetter(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"a", "b", "c"} {
+		if err := d.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	n, err := d.Replay(func(line []byte) error {
+		if string(line) == "b" {
+			return fmt.Errorf("collector down")
+		}
+		return nil
+	})
+	if err == nil || n != 1 {
+		t.Fatalf("Replay = %d, %v; want 1 and an error", n, err)
+	}
+	var rest []string
+	if _, err := d.Replay(func(line []byte) error {
+		rest = append(rest, string(line))
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(rest) != "[b c]" {
+		t.Errorf("second replay sent %v, want [b c]", rest)
+	}
+}
+
+func TestReplayKeepsUnreadableTail(t *testing.T) {
+	saved := maxDeadLetterLine
+	t.Cleanup(func() { maxDeadLetterLine = saved })
+	maxDeadLetterLine = 64
+
+	dir := t.TempDir()
+	d, err := OpenDeadLetter(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	long := strings.Repeat("x", 100)
+	for _, line := range []string{"a", "b", long, "c"} {
+		if err := d.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var sent []string
+	send := func(line []byte) error {
+		sent = append(sent, string(line))
+		return nil
+	}
+	if n, err := d.Replay(send); err == nil || n != 2 {
+		t.Fatalf("Replay = %d, %v; want 2 and an error", n, err)
+	}
+	// the next replay stops at the same line without resending a and b
+	if n, err := d.Replay(send); err == nil || n != 0 {
+		t.Fatalf("second Replay = %d, %v; want 0 and an error", n, err)
+	}
+	if fmt.Sprint(sent) != "[a b]" {
+		t.Errorf("sent %v, want [a b]", sent)
+	}
+
+	maxDeadLetterLine = saved
+	sent = nil
+	if _, err := d.Replay(send); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(sent) != fmt.Sprint([]string{long, "c"}) {
+		t.Errorf("replay with a larger limit sent %v, want the long line and c", sent)
+	}
+}
+
+func TestConcurrentReplaysSendOnce(t *testing.T) {
+	dir := t.TempDir()
+	d, err := OpenDeadLetter(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a file left over from an interrupted replay
+	var lines []byte
+	for i := 0; i < 100; i++ {
+		lines = append(lines, fmt.Sprintf("line-%d\n", i)...)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "deadletter-20260101"+deadLetterExt+deadLetterReplay), lines, 0o640); err != nil {
+		t.Fatal(err)
+	}
+	var sent atomic.Int64
+	// hold the first replay so the others start while it is running
+	var slow sync.Once
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := d.Replay(func([]byte) error {
+				slow.Do(func() { time.Sleep(50 * time.Millisecond) })
+				sent.Add(1)
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := sent.Load(); n != 100 {
+		t.Errorf("sent %d lines, want each of the 100 once", n)
+	}
+}
@@ -0,0 +1,471 @@
+// Package queue implements a FIFO backed by append-only segment files, so
+// queued payloads survive restarts and collector outages.
+//
+// Records are read in order and must be acknowledged once they have been
+// delivered. A segment file is deleted when all of its records are
+// acknowledged; records still on disk at startup are replayed. When the queue
+// outgrows its disk budget the oldest segment is evicted.
+package queue
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	segmentExt = ".seg"
+	headerSize = 8 // uint32 length + uint32 CRC32 (IEEE) of the data
+	// maxRecordSize guards against reading a corrupt length as a huge alloc.
+	maxRecordSize = 256 << 20
+)
+
+// ErrClosed is returned by Append and Next once the queue is closed.
+var ErrClosed = errors.New("queue closed")
+
+// Options configures a Queue.
+type Options struct {
+	Dir          string
+	SegmentBytes int64 // rotate to a new segment file after this many bytes
+	MaxBytes     int64 // total disk budget; 0 means unlimited
+	// SyncEveryAppend fsyncs each record before Append returns, so records
+	// also survive an OS crash or power loss. Otherwise segments are only
+	// synced when sealed and on Close.
+	SyncEveryAppend bool
+	// OnEvict, if set, is called with the number of undelivered records lost
+	// to an eviction.
+	OnEvict func(records int)
+}
+
+// ID identifies a record for Ack.
+type ID struct {
+	segment uint64
+}
+
+// Record is a payload returned by Next.
+type Record struct {
+	ID   ID
+	Data []byte
+}
+
+type segment struct {
+	seq     uint64
+	path    string
+	size    int64
+	written int // records in the file
+	acked   int
+	sealed  bool // no further appends
+	read    bool // the reader has returned every record
+}
+
+// Queue is safe for concurrent use; Next is meant for a single reader.
+type Queue struct {
+	opts Options
+
+	mu       sync.Mutex
+	segments []*segment // oldest first; the last one is being written
+	bySeq    map[uint64]*segment
+	total    int64
+	w        *os.File
+	nextSeq  uint64
+	closed   bool
+	notify   chan struct{}
+	done     chan struct{}
+
+	// reader position
+	rSeg  *segment
+	rFile *os.File
+	rRead int // records of rSeg already returned
+}
+
+// Open opens or creates the queue in opts.Dir. Segments left over from a
+// previous run are queued for replay ahead of anything appended later.
+func Open(opts Options) (*Queue, error) {
+	if opts.SegmentBytes <= 0 {
+		opts.SegmentBytes = 16 << 20
+	}
+	if err := os.MkdirAll(opts.Dir, 0o750); err != nil {
+		return nil, err
+	}
+	q := &Queue{
+		opts:   opts,
+		bySeq:  make(map[uint64]*segment),
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+	if err := q.rotate(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// load scans existing segment files, dropping a torn record at the tail of a
+// file (crash mid-write) and files that hold nothing.
+func (q *Queue) load() error {
+	entries, err := os.ReadDir(q.opts.Dir)
+	if err != nil {
+		return err
+	}
+	var seqs []uint64
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	replay := 0
+	for _, seq := range seqs {
+		path := q.segmentPath(seq)
+		n, size, err := scanSegment(path)
+		if err != nil {
+			return fmt.Errorf("scan %s: %w", path, err)
+		}
+		if n == 0 {
+			_ = os.Remove(path)
+			continue
+		}
+		s := &segment{seq: seq, path: path, size: size, written: n, sealed: true}
+		q.segments = append(q.segments, s)
+		q.bySeq[seq] = s
+		q.total += size
+		replay += n
+	}
+	if len(seqs) > 0 {
+		q.nextSeq = seqs[len(seqs)-1] + 1
+	}
+	if replay > 0 {
+		log.Printf("Disk queue: replaying %d records from %d segments", replay, len(q.segments))
+	}
+	return nil
+}
+
+// scanSegment counts the valid records in a file and truncates anything after
+// the last one.
+func scanSegment(path string) (records int, size int64, err error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	var off int64
+	for {
+		data, err := readRecord(f)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				log.Printf("Disk queue: truncating %s at offset %d: %v", path, off, err)
+			}
+			break
+		}
+		off += int64(headerSize + len(data))
+		records++
+	}
+	if err := f.Truncate(off); err != nil {
+		return 0, 0, err
+	}
+	return records, off, nil
+}
+
+func readRecord(r io.Reader) ([]byte, error) {
+	var hdr [headerSize]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("torn header: %w", err)
+		}
+		return nil, err
+	}
+	n := binary.LittleEndian.Uint32(hdr[0:4])
+	if n > maxRecordSize {
+		return nil, fmt.Errorf("record length %d exceeds limit", n)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("torn record: %w", err)
+	}
+	if crc32.ChecksumIEEE(data) != binary.LittleEndian.Uint32(hdr[4:8]) {
+		return nil, errors.New("checksum mismatch")
+	}
+	return data, nil
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	return errors.Join(err, d.Close())
+}
+
+func (q *Queue) segmentPath(seq uint64) string {
+	return filepath.Join(q.opts.Dir, fmt.Sprintf("%020d%s", seq, segmentExt))
+}
+
+// rotate seals the current segment and starts a new one. Called with q.mu
+// held (or before the queue is shared).
+func (q *Queue) rotate() error {
+	if q.w != nil {
+		if err := q.w.Sync(); err != nil {
+			return err
+		}
+		if err := q.w.Close(); err != nil {
+			return err
+		}
+		cur := q.segments[len(q.segments)-1]
+		cur.sealed = true
+		q.maybeRemove(cur)
+	}
+	seq := q.nextSeq
+	q.nextSeq++
+	path := q.segmentPath(seq)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND|os.O_TRUNC, 0o640)
+	if err != nil {
+		q.w = nil
+		return err
+	}
+	// make the new file's directory entry durable as well
+	if err := syncDir(q.opts.Dir); err != nil {
+		_ = f.Close()
+		q.w = nil
+		return err
+	}
+	s := &segment{seq: seq, path: path}
+	q.w = f
+	q.segments = append(q.segments, s)
+	q.bySeq[seq] = s
+	return nil
+}
+
+// Append writes one record. It never blocks on readers: if the disk budget is
+// exceeded the oldest segment is evicted instead.
+func (q *Queue) Append(data []byte) error {
+	if len(data) > maxRecordSize {
+		return fmt.Errorf("record of %d bytes exceeds limit", len(data))
+	}
+	buf := make([]byte, headerSize+len(data))
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(data)))
+	binary.LittleEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(data))
+	copy(buf[headerSize:], data)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return ErrClosed
+	}
+	cur := q.segments[len(q.segments)-1]
+	if cur.size > 0 && cur.size+int64(len(buf)) > q.opts.SegmentBytes {
+		if err := q.rotate(); err != nil {
+			return err
+		}
+		cur = q.segments[len(q.segments)-1]
+	}
+	if q.w == nil {
+		// a previous rotate failed; retry opening a segment
+		if err := q.rotate(); err != nil {
+			return err
+		}
+		cur = q.segments[len(q.segments)-1]
+	}
+	if _, err := q.w.Write(buf); err != nil {
+		return err
+	}
+	if q.opts.SyncEveryAppend {
+		if err := q.w.Sync(); err != nil {
+			return err
+		}
+	}
+	cur.size += int64(len(buf))
+	cur.written++
+	q.total += int64(len(buf))
+	q.evict()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// evict drops the oldest segments while over budget, keeping the one being
+// written.
+func (q *Queue) evict() {
+	if q.opts.MaxBytes <= 0 {
+		return
+	}
+	for q.total > q.opts.MaxBytes && len(q.segments) > 1 {
+		s := q.segments[0]
+		lost := s.written - s.acked
+		if s == q.rSeg {
+			lost = s.written - q.rRead
+			q.closeReader()
+		}
+		log.Printf("Disk queue over %d bytes, evicted segment %d (%d unsent records)", q.opts.MaxBytes, s.seq, lost)
+		q.remove(s)
+		if q.opts.OnEvict != nil && lost > 0 {
+			q.opts.OnEvict(lost)
+		}
+	}
+}
+
+// Size returns the bytes currently held on disk.
+func (q *Queue) Size() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.total
+}
+
+// Next blocks until a record is available, ctx is done or the queue closes.
+func (q *Queue) Next(ctx context.Context) (Record, error) {
+	for {
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return Record{}, ErrClosed
+		}
+		rec, ok, err := q.tryRead()
+		q.mu.Unlock()
+		if err != nil || ok {
+			return rec, err
+		}
+		select {
+		case <-q.notify:
+		case <-q.done:
+		case <-ctx.Done():
+			return Record{}, ctx.Err()
+		}
+	}
+}
+
+// tryRead returns the next unread record, if any. Called with q.mu held.
+func (q *Queue) tryRead() (Record, bool, error) {
+	for {
+		if q.rSeg == nil {
+			s := q.firstUnread()
+			if s == nil {
+				return Record{}, false, nil
+			}
+			f, err := os.Open(s.path)
+			if err != nil {
+				return Record{}, false, err
+			}
+			q.rSeg, q.rFile, q.rRead = s, f, 0
+		}
+		s := q.rSeg
+		if q.rRead < s.written {
+			data, err := readRecord(q.rFile)
+			if err != nil {
+				// skip the unreadable remainder rather than stall the queue;
+				// new records go to a fresh segment
+				if !s.sealed {
+					if rerr := q.rotate(); rerr != nil {
+						log.Printf("Disk queue: rotate: %v", rerr)
+					}
+				}
+				s.read = true
+				s.acked += s.written - q.rRead
+				q.closeReader()
+				q.maybeRemove(s)
+				return Record{}, false, fmt.Errorf("read segment %d: %w", s.seq, err)
+			}
+			q.rRead++
+			return Record{ID: ID{segment: s.seq}, Data: data}, true, nil
+		}
+		if !s.sealed {
+			return Record{}, false, nil
+		}
+		s.read = true
+		q.closeReader()
+		q.maybeRemove(s)
+	}
+}
+
+// firstUnread returns the oldest segment the reader has not finished.
+func (q *Queue) firstUnread() *segment {
+	for _, s := range q.segments {
+		if !s.read {
+			return s
+		}
+	}
+	return nil
+}
+
+func (q *Queue) closeReader() {
+	if q.rFile != nil {
+		_ = q.rFile.Close()
+	}
+	q.rSeg, q.rFile, q.rRead = nil, nil, 0
+}
+
+// Ack marks a record as delivered. Acks for evicted segments are ignored.
+func (q *Queue) Ack(id ID) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	s, ok := q.bySeq[id.segment]
+	if !ok {
+		return
+	}
+	s.acked++
+	q.maybeRemove(s)
+}
+
+func (q *Queue) maybeRemove(s *segment) {
+	if s.sealed && s.acked >= s.written && s != q.rSeg {
+		q.remove(s)
+	}
+}
+
+func (q *Queue) remove(s *segment) {
+	for i, x := range q.segments {
+		if x == s {
+			q.segments = append(q.segments[:i], q.segments[i+1:]...)
+			break
+		}
+	}
+	delete(q.bySeq, s.seq)
+	q.total -= s.size
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Printf("Disk queue: remove %s: %v", s.path, err)
+	}
+}
+
+// Close stops readers and closes the files. Unacknowledged records stay on
+// disk for the next Open.
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return nil
+	}
+	q.closed = true
+	close(q.done)
+	q.closeReader()
+	if q.w == nil {
+		return nil
+	}
+	err := errors.Join(q.w.Sync(), q.w.Close())
+	q.w = nil
+	// drop the active segment too if everything in it was delivered
+	cur := q.segments[len(q.segments)-1]
+	cur.sealed = true
+	q.maybeRemove(cur)
+	return err
+}
@@ -0,0 +1,199 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func openQueue(t *testing.T, opts Options) *Queue {
+	t.Helper()
+	q, err := Open(opts)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	return q
+}
+
+func next(t *testing.T, q *Queue) (Record, error) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	return q.Next(ctx)
+}
+
+func segmentFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func TestReplayAtStartup(t *testing.T) {
+	dir := t.TempDir()
+	q := openQueue(t, Options{Dir: dir})
+	for i := 0; i < 3; i++ {
+		if err := q.Append([]byte(fmt.Sprintf("record-%d", i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rec, err := next(t, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q.Ack(rec.ID)
+	if err := q.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	q = openQueue(t, Options{Dir: dir})
+	defer q.Close()
+	// the segment is only removed once all of it is acked, so all three come back
+	for i := 0; i < 3; i++ {
+		rec, err := next(t, q)
+		if err != nil {
+			t.Fatalf("record %d: %v", i, err)
+		}
+		if want := fmt.Sprintf("record-%d", i); string(rec.Data) != want {
+			t.Errorf("record %d = %q, want %q", i, rec.Data, want)
+		}
+	}
+	if _, err := next(t, q); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("extra record after replay: %v", err)
+	}
+}
+
+func TestDamagedTailIsTruncated(t *testing.T) {
+	tests := []struct {
+		name   string
+		damage func(t *testing.T, path string)
+	}{
+		{"torn header", func(t *testing.T, path string) {
+			appendBytes(t, path, []byte{5, 0, 0})
+		}},
+		{"torn record", func(t *testing.T, path string) {
+			appendBytes(t, path, []byte{100, 0, 0, 0, 1, 2, 3, 4, 'x'})
+		}},
+		{"bad checksum", func(t *testing.T, path string) {
+			appendBytes(t, path, []byte{1, 0, 0, 0, 0xde, 0xad, 0xbe, 0xef, 'x'})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			q := openQueue(t, Options{Dir: dir})
+			if err := q.Append([]byte("good")); err != nil {
+				t.Fatal(err)
+			}
+			if err := q.Close(); err != nil {
+				t.Fatal(err)
+			}
+			files := segmentFiles(t, dir)
+			if len(files) != 1 {
+				t.Fatalf("got %d segment files, want 1", len(files))
+			}
+			info, _ := os.Stat(files[0])
+			tt.damage(t, files[0])
+
+			q = openQueue(t, Options{Dir: dir})
+			defer q.Close()
+			rec, err := next(t, q)
+			if err != nil || string(rec.Data) != "good" {
+				t.Fatalf("got %q, %v; want the intact record", rec.Data, err)
+			}
+			if _, err := next(t, q); !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("damaged record was returned: %v", err)
+			}
+			after, _ := os.Stat(files[0])
+			if after.Size() != info.Size() {
+				t.Errorf("segment is %d bytes after load, want truncated to %d", after.Size(), info.Size())
+			}
+		})
+	}
+}
+
+func appendBytes(t *testing.T, path string, b []byte) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(b); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAckRemovesSealedSegments(t *testing.T) {
+	dir := t.TempDir()
+	// every record fills a segment, so each Append after the first rotates
+	q := openQueue(t, Options{Dir: dir, SegmentBytes: 1, SyncEveryAppend: true})
+	defer q.Close()
+	for _, s := range []string{"a", "b", "c"} {
+		if err := q.Append([]byte(s)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := len(segmentFiles(t, dir)); n != 3 {
+		t.Fatalf("got %d segment files, want 3", n)
+	}
+	var ids []ID
+	for i := 0; i < 3; i++ {
+		rec, err := next(t, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, rec.ID)
+	}
+	q.Ack(ids[1])
+	q.Ack(ids[0])
+	// the last segment is still being written and stays
+	if n := len(segmentFiles(t, dir)); n != 1 {
+		t.Errorf("got %d segment files after acking two sealed ones, want 1", n)
+	}
+	q.Ack(ids[2])
+	if err := q.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(segmentFiles(t, dir)); n != 0 {
+		t.Errorf("got %d segment files after acking everything, want 0", n)
+	}
+}
+
+func TestEvictsOldestSegment(t *testing.T) {
+	dir := t.TempDir()
+	evicted := 0
+	record := make([]byte, 100)
+	q := openQueue(t, Options{
+		Dir:          dir,
+		SegmentBytes: 1,
+		MaxBytes:     2 * (headerSize + 100),
+		OnEvict:      func(n int) { evicted += n },
+	})
+	defer q.Close()
+	for i := 0; i < 3; i++ {
+		record[0] = byte('0' + i)
+		if err := q.Append(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if evicted != 1 {
+		t.Errorf("evicted %d records, want 1", evicted)
+	}
+	if size := q.Size(); size > 2*(headerSize+100) {
+		t.Errorf("queue holds %d bytes, over the budget", size)
+	}
+	rec, err := next(t, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Data[0] != '1' {
+		t.Errorf("first record after eviction is %q, want the second one appended", rec.Data[0])
+	}
+}
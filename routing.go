@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"strings"
+
+	"skywalking_transformer/converter"
+	"skywalking_transformer/metrics"
+	"skywalking_transformer/skywalking"
+)
+
+// ----------- Routing -----------
+
+// routeRule sends segments whose service matches all of its (glob) patterns
+// to a set of exporters. Empty patterns match anything.
+type routeRule struct {
+	service     string
+	teamID      string
+	serviceType string
+	instance    string
+	targets     []*pipeline
+}
+
+var (
+	routeRules    []routeRule
+	routeFallback []*pipeline // for segments no rule matches
+)
+
+// loadRoutes reads CODEXRAY_ROUTES, rules separated by ";" and tried in order:
+//
+//	service=billing-*&type=java -> billing,default; teamID=acme -> acme
+//
+// Conditions are service, teamID, type and instance, joined by "&" and
+// matched as path.Match globs. Segments no rule matches go to the exporters
+// in CODEXRAY_ROUTE_DEFAULT; without it to all exporters when there are no
+// rules, else to the "default" exporter if there is one, else nowhere.
+func loadRoutes() error {
+	byName := map[string]*pipeline{}
+	for _, p := range pipelines {
+		byName[p.name] = p
+	}
+	lookup := func(names string) ([]*pipeline, error) {
+		var out []*pipeline
+		for _, name := range strings.Split(names, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" {
+				continue
+			}
+			p, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown exporter %q", name)
+			}
+			out = append(out, p)
+		}
+		return out, nil
+	}
+
+	for _, spec := range strings.Split(os.Getenv("CODEXRAY_ROUTES"), ";") {
+		if strings.TrimSpace(spec) == "" {
+			continue
+		}
+		rule, err := parseRouteRule(spec, lookup)
+		if err != nil {
+			return fmt.Errorf("route %q: %w", strings.TrimSpace(spec), err)
+		}
+		routeRules = append(routeRules, rule)
+	}
+
+	switch def, ok := os.LookupEnv("CODEXRAY_ROUTE_DEFAULT"); {
+	case ok:
+		fallback, err := lookup(def)
+		if err != nil {
+			return fmt.Errorf("CODEXRAY_ROUTE_DEFAULT: %w", err)
+		}
+		routeFallback = fallback
+	case len(routeRules) == 0:
+		routeFallback = pipelines
+	default:
+		if p, ok := byName[defaultExporter]; ok {
+			routeFallback = []*pipeline{p}
+		}
+	}
+	return nil
+}
+
+func parseRouteRule(spec string, lookup func(string) ([]*pipeline, error)) (routeRule, error) {
+	var rule routeRule
+	match, targets, ok := strings.Cut(spec, "->")
+	if !ok {
+		return rule, fmt.Errorf(`missing "->"`)
+	}
+	for _, cond := range strings.Split(match, "&") {
+		cond = strings.TrimSpace(cond)
+		if cond == "" || cond == "*" {
+			continue
+		}
+		key, pattern, ok := strings.Cut(cond, "=")
+		if !ok {
+			return rule, fmt.Errorf("condition %q is not key=pattern", cond)
+		}
+		pattern = strings.TrimSpace(pattern)
+		if _, err := path.Match(pattern, ""); err != nil {
+			return rule, fmt.Errorf("condition %q: %w", cond, err)
+		}
+		switch strings.TrimSpace(key) {
+		case "service":
+			rule.service = pattern
+		case "teamID":
+			rule.teamID = pattern
+		case "type":
+			rule.serviceType = pattern
+		case "instance":
+			rule.instance = pattern
+		default:
+			return rule, fmt.Errorf("unknown condition %q (want service, teamID, type or instance)", key)
+		}
+	}
+	var err error
+	if rule.targets, err = lookup(targets); err != nil {
+		return rule, err
+	}
+	if len(rule.targets) == 0 {
+		return rule, fmt.Errorf("no exporters")
+	}
+	return rule, nil
+}
+
+func (r *routeRule) matches(svc converter.Service, instance string) bool {
+	return globMatch(r.service, svc.Name) &&
+		globMatch(r.teamID, svc.TeamID) &&
+		globMatch(r.serviceType, svc.Type) &&
+		globMatch(r.instance, instance)
+}
+
+func globMatch(pattern, s string) bool {
+	if pattern == "" {
+		return true
+	}
+	ok, _ := path.Match(pattern, s) // validated in parseRouteRule
+	return ok
+}
+
+// routeSegment picks the pipelines a segment goes to.
+func routeSegment(segment *skywalking.TraceSegment) []*pipeline {
+	if len(routeRules) == 0 {
+		return routeFallback
+	}
+	svc, _ := converter.ParseService(segment.Service)
+	return routeService(svc, segment.ServiceInstance)
+}
+
+// routeService picks the pipelines for data of a service instance.
+func routeService(svc converter.Service, instance string) []*pipeline {
+	for i := range routeRules {
+		if routeRules[i].matches(svc, instance) {
+			return routeRules[i].targets
+		}
+	}
+	return routeFallback
+}
+
+func logRoutes() {
+	names := func(ps []*pipeline) string {
+		s := make([]string, len(ps))
+		for i, p := range ps {
+			s[i] = p.name
+		}
+		return strings.Join(s, ",")
+	}
+	for _, r := range routeRules {
+		log.Printf("Route service=%q teamID=%q type=%q instance=%q -> %s",
+			r.service, r.teamID, r.serviceType, r.instance, names(r.targets))
+	}
+	if len(routeFallback) == 0 {
+		log.Printf("Unrouted segments are dropped")
+		return
+	}
+	log.Printf("Unrouted segments -> %s", names(routeFallback))
+}
+
+// countUnrouted records a segment that matched no route and has no fallback.
+func countUnrouted() {
+	metrics.Dropped.WithLabelValues("", "unrouted").Inc()
+}
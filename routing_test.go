@@ -0,0 +1,118 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"skywalking_transformer/converter"
+	"skywalking_transformer/skywalking"
+)
+
+// setupRoutes loads CODEXRAY_ROUTES and CODEXRAY_ROUTE_DEFAULT for pipelines
+// with the given names, restoring the routing globals afterwards.
+func setupRoutes(t *testing.T, names []string, routes string, fallback *string) error {
+	t.Helper()
+	savedPipelines, savedRules, savedFallback := pipelines, routeRules, routeFallback
+	t.Cleanup(func() {
+		pipelines, routeRules, routeFallback = savedPipelines, savedRules, savedFallback
+	})
+	pipelines, routeRules, routeFallback = nil, nil, nil
+	for _, name := range names {
+		pipelines = append(pipelines, &pipeline{name: name})
+	}
+	t.Setenv("CODEXRAY_ROUTES", routes)
+	if fallback != nil {
+		t.Setenv("CODEXRAY_ROUTE_DEFAULT", *fallback)
+	}
+	return loadRoutes()
+}
+
+func pipelineNames(ps []*pipeline) string {
+	names := make([]string, len(ps))
+	for i, p := range ps {
+		names[i] = p.name
+	}
+	return strings.Join(names, ",")
+}
+
+func TestRouteService(t *testing.T) {
+	const routes = "teamID=acme -> acme; service=billing-*&type=java -> billing,default; instance=canary-? -> canary"
+	tests := []struct {
+		name     string
+		svc      converter.Service
+		instance string
+		want     string
+	}{
+		{"team", converter.Service{Name: "shop", TeamID: "acme"}, "i1", "acme"},
+		{"service and type", converter.Service{Name: "billing-api", Type: "java"}, "i1", "billing,default"},
+		{"service without type", converter.Service{Name: "billing-api", Type: "python"}, "i1", "default"},
+		{"instance", converter.Service{Name: "shop"}, "canary-1", "canary"},
+		{"instance glob is one character", converter.Service{Name: "shop"}, "canary-12", "default"},
+		{"first match wins", converter.Service{Name: "billing-api", TeamID: "acme", Type: "java"}, "canary-1", "acme"},
+		{"no match", converter.Service{Name: "shop"}, "i1", "default"},
+	}
+	if err := setupRoutes(t, []string{"default", "acme", "billing", "canary"}, routes, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pipelineNames(routeService(tt.svc, tt.instance)); got != tt.want {
+				t.Errorf("routeService(%+v, %q) = %s, want %s", tt.svc, tt.instance, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRouteFallback(t *testing.T) {
+	none, other := "", "acme"
+	tests := []struct {
+		name     string
+		names    []string
+		routes   string
+		fallback *string
+		want     string
+	}{
+		{"no rules go everywhere", []string{"default", "acme"}, "", nil, "default,acme"},
+		{"rules fall back to default", []string{"default", "acme"}, "teamID=x -> acme", nil, "default"},
+		{"no default exporter drops", []string{"acme", "billing"}, "teamID=x -> acme", nil, ""},
+		{"explicit fallback", []string{"default", "acme"}, "teamID=x -> default", &other, "acme"},
+		{"empty fallback drops", []string{"default", "acme"}, "teamID=x -> acme", &none, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := setupRoutes(t, tt.names, tt.routes, tt.fallback); err != nil {
+				t.Fatal(err)
+			}
+			segment := &skywalking.TraceSegment{Service: "shop", ServiceInstance: "i1"}
+			if got := pipelineNames(routeSegment(segment)); got != tt.want {
+				t.Errorf("routeSegment = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadRoutesRejectsMalformedRules(t *testing.T) {
+	unknown := "nope"
+	tests := []struct {
+		name     string
+		routes   string
+		fallback *string
+		wantErr  string
+	}{
+		{"missing arrow", "teamID=acme acme", nil, `missing "->"`},
+		{"condition without pattern", "teamID -> acme", nil, "not key=pattern"},
+		{"unknown condition", "region=eu -> acme", nil, "unknown condition"},
+		{"bad glob", "service=[ -> acme", nil, "syntax error in pattern"},
+		{"unknown exporter", "teamID=acme -> jaeger", nil, `unknown exporter "jaeger"`},
+		{"no exporters", "teamID=acme -> ,", nil, "no exporters"},
+		{"unknown fallback", "teamID=acme -> acme", &unknown, "CODEXRAY_ROUTE_DEFAULT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := setupRoutes(t, []string{"default", "acme"}, tt.routes, tt.fallback)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("loadRoutes() error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package skywalking
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	agentv3 "skywalking_transformer/skywalking/v3"
+)
+
+// InstanceProperties is the body of /v3/management/reportProperties. CodeXray
+// agents send serviceId/serviceInstanceId and the properties as an object
+// (see payload.json); upstream agents send service/serviceInstance and a
+// key/value list. Both decode into the same form, array values becoming one
+// entry per element.
+type InstanceProperties struct {
+	Service         string
+	ServiceInstance string
+	Properties      []Tag
+	Layer           string
+}
+
+func (p *InstanceProperties) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Service           string          `json:"service"`
+		ServiceID         string          `json:"serviceId"`
+		ServiceInstance   string          `json:"serviceInstance"`
+		ServiceInstanceID string          `json:"serviceInstanceId"`
+		Properties        json.RawMessage `json:"properties"`
+		Layer             string          `json:"layer"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*p = InstanceProperties{
+		Service:         firstNonEmpty(raw.Service, raw.ServiceID),
+		ServiceInstance: firstNonEmpty(raw.ServiceInstance, raw.ServiceInstanceID),
+		Layer:           raw.Layer,
+	}
+	if len(raw.Properties) == 0 || string(raw.Properties) == "null" {
+		return nil
+	}
+	if raw.Properties[0] == '[' {
+		return json.Unmarshal(raw.Properties, &p.Properties)
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(raw.Properties, &obj); err != nil {
+		return fmt.Errorf("properties: %w", err)
+	}
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch v := obj[k].(type) {
+		case []any:
+			for _, e := range v {
+				p.Properties = append(p.Properties, Tag{Key: k, Value: propertyString(e)})
+			}
+		default:
+			p.Properties = append(p.Properties, Tag{Key: k, Value: propertyString(v)})
+		}
+	}
+	return nil
+}
+
+// InstancePing is the body of /v3/management/keepAlive, in either naming.
+type InstancePing struct {
+	Service         string
+	ServiceInstance string
+	Layer           string
+}
+
+func (p *InstancePing) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Service           string `json:"service"`
+		ServiceID         string `json:"serviceId"`
+		ServiceInstance   string `json:"serviceInstance"`
+		ServiceInstanceID string `json:"serviceInstanceId"`
+		Layer             string `json:"layer"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*p = InstancePing{
+		Service:         firstNonEmpty(raw.Service, raw.ServiceID),
+		ServiceInstance: firstNonEmpty(raw.ServiceInstance, raw.ServiceInstanceID),
+		Layer:           raw.Layer,
+	}
+	return nil
+}
+
+func InstancePropertiesFromProto(p *agentv3.InstanceProperties) InstanceProperties {
+	return InstanceProperties{
+		Service:         p.GetService(),
+		ServiceInstance: p.GetServiceInstance(),
+		Properties:      tagsFromProto(p.GetProperties()),
+		Layer:           p.GetLayer(),
+	}
+}
+
+func InstancePingFromProto(p *agentv3.InstancePingPkg) InstancePing {
+	return InstancePing{
+		Service:         p.GetService(),
+		ServiceInstance: p.GetServiceInstance(),
+		Layer:           p.GetLayer(),
+	}
+}
+
+func propertyString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+func firstNonEmpty(a, b string) string {
+	if a != "" {
+		return a
+	}
+	return b
+}
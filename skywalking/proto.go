@@ -0,0 +1,65 @@
+package skywalking
+
+import (
+	agentv3 "skywalking_transformer/skywalking/v3"
+)
+
+// SegmentFromProto maps a segment received over gRPC onto the JSON model, so
+// both transports share one converter. Enums keep their SkyWalking names
+// ("Entry", "Http", "CrossProcess"), matching what JSON agents send.
+func SegmentFromProto(s *agentv3.SegmentObject) TraceSegment {
+	segment := TraceSegment{
+		TraceID:         s.GetTraceId(),
+		Service:         s.GetService(),
+		ServiceInstance: s.GetServiceInstance(),
+		TraceSegmentId:  s.GetTraceSegmentId(),
+		IsSizeLimited:   s.GetIsSizeLimited(),
+		Spans:           make([]Span, 0, len(s.GetSpans())),
+	}
+	for _, sp := range s.GetSpans() {
+		span := Span{
+			SpanID:        int(sp.GetSpanId()),
+			ParentSpanID:  int(sp.GetParentSpanId()),
+			OperationName: sp.GetOperationName(),
+			SpanType:      sp.GetSpanType().String(),
+			IsError:       BoolInt(sp.GetIsError()),
+			StartTime:     sp.GetStartTime(),
+			EndTime:       sp.GetEndTime(),
+			Peer:          sp.GetPeer(),
+			ComponentId:   int(sp.GetComponentId()),
+			SkipAnalysis:  sp.GetSkipAnalysis(),
+			Tags:          tagsFromProto(sp.GetTags()),
+		}
+		if sp.GetSpanLayer() != agentv3.SpanLayer_Unknown {
+			span.SpanLayer = sp.GetSpanLayer().String()
+		}
+		for _, l := range sp.GetLogs() {
+			span.Logs = append(span.Logs, Log{Time: l.GetTime(), Data: tagsFromProto(l.GetData())})
+		}
+		for _, ref := range sp.GetRefs() {
+			span.References = append(span.References, Reference{
+				RefType:                  RefType(ref.GetRefType().String()),
+				TraceId:                  ref.GetTraceId(),
+				ParentTraceSegmentId:     ref.GetParentTraceSegmentId(),
+				ParentSpanId:             int(ref.GetParentSpanId()),
+				ParentService:            ref.GetParentService(),
+				ParentServiceInstance:    ref.GetParentServiceInstance(),
+				ParentEndpoint:           ref.GetParentEndpoint(),
+				NetworkAddressUsedAtPeer: ref.GetNetworkAddressUsedAtPeer(),
+			})
+		}
+		segment.Spans = append(segment.Spans, span)
+	}
+	return segment
+}
+
+func tagsFromProto(kvs []*agentv3.KeyStringValuePair) []Tag {
+	if len(kvs) == 0 {
+		return nil
+	}
+	tags := make([]Tag, 0, len(kvs))
+	for _, kv := range kvs {
+		tags = append(tags, Tag{Key: kv.GetKey(), Value: kv.GetValue()})
+	}
+	return tags
+}
@@ -62,10 +62,51 @@ type Log struct {
 	Data []Tag `json:"data"`
 }
 
-// Reference now auto-decodes `headers` into a map
+// RefType is the SkyWalking v3 reference type. Agents send it either as the
+// enum name or as its number, so both are accepted.
+type RefType string
+
+const (
+	RefTypeCrossProcess RefType = "CrossProcess"
+	RefTypeCrossThread  RefType = "CrossThread"
+)
+
+func (t *RefType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*t = RefType(s)
+		return nil
+	}
+
+	var n int
+	if err := json.Unmarshal(data, &n); err == nil {
+		switch n {
+		case 0:
+			*t = RefTypeCrossProcess
+		case 1:
+			*t = RefTypeCrossThread
+		default:
+			*t = RefType(fmt.Sprintf("%d", n))
+		}
+		return nil
+	}
+
+	return fmt.Errorf("invalid value for RefType: %s", string(data))
+}
+
+// Reference models the SkyWalking v3 SegmentReference, which points at the
+// span in another segment that this segment continues from.
+// `headers` is auto-decoded into a map.
 type Reference struct {
-	TraceId string            `json:"traceId"`
-	Headers map[string]string `json:"headers"`
+	RefType                  RefType           `json:"refType"`
+	TraceId                  string            `json:"traceId"`
+	ParentTraceSegmentId     string            `json:"parentTraceSegmentId"`
+	ParentSpanId             int               `json:"parentSpanId"`
+	ParentService            string            `json:"parentService"`
+	ParentServiceInstance    string            `json:"parentServiceInstance"`
+	ParentEndpoint           string            `json:"parentEndpoint"`
+	NetworkAddressUsedAtPeer string            `json:"networkAddressUsedAtPeer"`
+	Headers                  map[string]string `json:"headers"`
 }
 
 func (r *Reference) UnmarshalJSON(data []byte) error {
@@ -0,0 +1,126 @@
+// Subset of the Apache SkyWalking data collect protocol (v3),
+// https://github.com/apache/skywalking-data-collect-protocol, licensed under
+// the Apache License 2.0. Field numbers must stay identical to upstream.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: skywalking/v3/CLRMetric.proto
+
+package v3
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CLRMetricReportService_Collect_FullMethodName = "/skywalking.v3.CLRMetricReportService/collect"
+)
+
+// CLRMetricReportServiceClient is the client API for CLRMetricReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CLRMetricReportServiceClient interface {
+	Collect(ctx context.Context, in *CLRMetricCollection, opts ...grpc.CallOption) (*Commands, error)
+}
+
+type cLRMetricReportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCLRMetricReportServiceClient(cc grpc.ClientConnInterface) CLRMetricReportServiceClient {
+	return &cLRMetricReportServiceClient{cc}
+}
+
+func (c *cLRMetricReportServiceClient) Collect(ctx context.Context, in *CLRMetricCollection, opts ...grpc.CallOption) (*Commands, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Commands)
+	err := c.cc.Invoke(ctx, CLRMetricReportService_Collect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CLRMetricReportServiceServer is the server API for CLRMetricReportService service.
+// All implementations must embed UnimplementedCLRMetricReportServiceServer
+// for forward compatibility.
+type CLRMetricReportServiceServer interface {
+	Collect(context.Context, *CLRMetricCollection) (*Commands, error)
+	mustEmbedUnimplementedCLRMetricReportServiceServer()
+}
+
+// UnimplementedCLRMetricReportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCLRMetricReportServiceServer struct{}
+
+func (UnimplementedCLRMetricReportServiceServer) Collect(context.Context, *CLRMetricCollection) (*Commands, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Collect not implemented")
+}
+func (UnimplementedCLRMetricReportServiceServer) mustEmbedUnimplementedCLRMetricReportServiceServer() {
+}
+func (UnimplementedCLRMetricReportServiceServer) testEmbeddedByValue() {}
+
+// UnsafeCLRMetricReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CLRMetricReportServiceServer will
+// result in compilation errors.
+type UnsafeCLRMetricReportServiceServer interface {
+	mustEmbedUnimplementedCLRMetricReportServiceServer()
+}
+
+func RegisterCLRMetricReportServiceServer(s grpc.ServiceRegistrar, srv CLRMetricReportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCLRMetricReportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CLRMetricReportService_ServiceDesc, srv)
+}
+
+func _CLRMetricReportService_Collect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CLRMetricCollection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CLRMetricReportServiceServer).Collect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CLRMetricReportService_Collect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CLRMetricReportServiceServer).Collect(ctx, req.(*CLRMetricCollection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CLRMetricReportService_ServiceDesc is the grpc.ServiceDesc for CLRMetricReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CLRMetricReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "skywalking.v3.CLRMetricReportService",
+	HandlerType: (*CLRMetricReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "collect",
+			Handler:    _CLRMetricReportService_Collect_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "skywalking/v3/CLRMetric.proto",
+}
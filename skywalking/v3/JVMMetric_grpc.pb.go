@@ -0,0 +1,126 @@
+// Subset of the Apache SkyWalking data collect protocol (v3),
+// https://github.com/apache/skywalking-data-collect-protocol, licensed under
+// the Apache License 2.0. Field numbers must stay identical to upstream.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: skywalking/v3/JVMMetric.proto
+
+package v3
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	JVMMetricReportService_Collect_FullMethodName = "/skywalking.v3.JVMMetricReportService/collect"
+)
+
+// JVMMetricReportServiceClient is the client API for JVMMetricReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JVMMetricReportServiceClient interface {
+	Collect(ctx context.Context, in *JVMMetricCollection, opts ...grpc.CallOption) (*Commands, error)
+}
+
+type jVMMetricReportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJVMMetricReportServiceClient(cc grpc.ClientConnInterface) JVMMetricReportServiceClient {
+	return &jVMMetricReportServiceClient{cc}
+}
+
+func (c *jVMMetricReportServiceClient) Collect(ctx context.Context, in *JVMMetricCollection, opts ...grpc.CallOption) (*Commands, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Commands)
+	err := c.cc.Invoke(ctx, JVMMetricReportService_Collect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JVMMetricReportServiceServer is the server API for JVMMetricReportService service.
+// All implementations must embed UnimplementedJVMMetricReportServiceServer
+// for forward compatibility.
+type JVMMetricReportServiceServer interface {
+	Collect(context.Context, *JVMMetricCollection) (*Commands, error)
+	mustEmbedUnimplementedJVMMetricReportServiceServer()
+}
+
+// UnimplementedJVMMetricReportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedJVMMetricReportServiceServer struct{}
+
+func (UnimplementedJVMMetricReportServiceServer) Collect(context.Context, *JVMMetricCollection) (*Commands, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Collect not implemented")
+}
+func (UnimplementedJVMMetricReportServiceServer) mustEmbedUnimplementedJVMMetricReportServiceServer() {
+}
+func (UnimplementedJVMMetricReportServiceServer) testEmbeddedByValue() {}
+
+// UnsafeJVMMetricReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JVMMetricReportServiceServer will
+// result in compilation errors.
+type UnsafeJVMMetricReportServiceServer interface {
+	mustEmbedUnimplementedJVMMetricReportServiceServer()
+}
+
+func RegisterJVMMetricReportServiceServer(s grpc.ServiceRegistrar, srv JVMMetricReportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedJVMMetricReportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&JVMMetricReportService_ServiceDesc, srv)
+}
+
+func _JVMMetricReportService_Collect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JVMMetricCollection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JVMMetricReportServiceServer).Collect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JVMMetricReportService_Collect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JVMMetricReportServiceServer).Collect(ctx, req.(*JVMMetricCollection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JVMMetricReportService_ServiceDesc is the grpc.ServiceDesc for JVMMetricReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JVMMetricReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "skywalking.v3.JVMMetricReportService",
+	HandlerType: (*JVMMetricReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "collect",
+			Handler:    _JVMMetricReportService_Collect_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "skywalking/v3/JVMMetric.proto",
+}
@@ -0,0 +1,706 @@
+// Subset of the Apache SkyWalking data collect protocol (v3),
+// https://github.com/apache/skywalking-data-collect-protocol, licensed under
+// the Apache License 2.0. Field numbers must stay identical to upstream.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: skywalking/v3/Logging.proto
+
+package v3
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LogData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Milliseconds since epoch; the receive time when unset.
+	Timestamp       int64         `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Service         string        `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	ServiceInstance string        `protobuf:"bytes,3,opt,name=serviceInstance,proto3" json:"serviceInstance,omitempty"`
+	Endpoint        string        `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Body            *LogDataBody  `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	TraceContext    *TraceContext `protobuf:"bytes,6,opt,name=traceContext,proto3" json:"traceContext,omitempty"`
+	Tags            *LogTags      `protobuf:"bytes,7,opt,name=tags,proto3" json:"tags,omitempty"`
+	Layer           string        `protobuf:"bytes,8,opt,name=layer,proto3" json:"layer,omitempty"`
+}
+
+func (x *LogData) Reset() {
+	*x = LogData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Logging_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogData) ProtoMessage() {}
+
+func (x *LogData) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Logging_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogData.ProtoReflect.Descriptor instead.
+func (*LogData) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Logging_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LogData) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LogData) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *LogData) GetServiceInstance() string {
+	if x != nil {
+		return x.ServiceInstance
+	}
+	return ""
+}
+
+func (x *LogData) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *LogData) GetBody() *LogDataBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *LogData) GetTraceContext() *TraceContext {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
+func (x *LogData) GetTags() *LogTags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *LogData) GetLayer() string {
+	if x != nil {
+		return x.Layer
+	}
+	return ""
+}
+
+type LogDataBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Types that are assignable to Content:
+	//	*LogDataBody_Text
+	//	*LogDataBody_Json
+	//	*LogDataBody_Yaml
+	Content isLogDataBody_Content `protobuf_oneof:"content"`
+}
+
+func (x *LogDataBody) Reset() {
+	*x = LogDataBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Logging_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogDataBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogDataBody) ProtoMessage() {}
+
+func (x *LogDataBody) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Logging_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogDataBody.ProtoReflect.Descriptor instead.
+func (*LogDataBody) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Logging_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LogDataBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (m *LogDataBody) GetContent() isLogDataBody_Content {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (x *LogDataBody) GetText() *TextLog {
+	if x, ok := x.GetContent().(*LogDataBody_Text); ok {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *LogDataBody) GetJson() *JSONLog {
+	if x, ok := x.GetContent().(*LogDataBody_Json); ok {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *LogDataBody) GetYaml() *YAMLLog {
+	if x, ok := x.GetContent().(*LogDataBody_Yaml); ok {
+		return x.Yaml
+	}
+	return nil
+}
+
+type isLogDataBody_Content interface {
+	isLogDataBody_Content()
+}
+
+type LogDataBody_Text struct {
+	Text *TextLog `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+type LogDataBody_Json struct {
+	Json *JSONLog `protobuf:"bytes,3,opt,name=json,proto3,oneof"`
+}
+
+type LogDataBody_Yaml struct {
+	Yaml *YAMLLog `protobuf:"bytes,4,opt,name=yaml,proto3,oneof"`
+}
+
+func (*LogDataBody_Text) isLogDataBody_Content() {}
+
+func (*LogDataBody_Json) isLogDataBody_Content() {}
+
+func (*LogDataBody_Yaml) isLogDataBody_Content() {}
+
+type TextLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *TextLog) Reset() {
+	*x = TextLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Logging_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextLog) ProtoMessage() {}
+
+func (x *TextLog) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Logging_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextLog.ProtoReflect.Descriptor instead.
+func (*TextLog) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Logging_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TextLog) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type JSONLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Json string `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *JSONLog) Reset() {
+	*x = JSONLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Logging_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONLog) ProtoMessage() {}
+
+func (x *JSONLog) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Logging_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONLog.ProtoReflect.Descriptor instead.
+func (*JSONLog) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Logging_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JSONLog) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+type YAMLLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Yaml string `protobuf:"bytes,1,opt,name=yaml,proto3" json:"yaml,omitempty"`
+}
+
+func (x *YAMLLog) Reset() {
+	*x = YAMLLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Logging_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *YAMLLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YAMLLog) ProtoMessage() {}
+
+func (x *YAMLLog) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Logging_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use YAMLLog.ProtoReflect.Descriptor instead.
+func (*YAMLLog) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Logging_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *YAMLLog) GetYaml() string {
+	if x != nil {
+		return x.Yaml
+	}
+	return ""
+}
+
+type TraceContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TraceId        string `protobuf:"bytes,1,opt,name=traceId,proto3" json:"traceId,omitempty"`
+	TraceSegmentId string `protobuf:"bytes,2,opt,name=traceSegmentId,proto3" json:"traceSegmentId,omitempty"`
+	SpanId         int32  `protobuf:"varint,3,opt,name=spanId,proto3" json:"spanId,omitempty"`
+}
+
+func (x *TraceContext) Reset() {
+	*x = TraceContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Logging_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceContext) ProtoMessage() {}
+
+func (x *TraceContext) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Logging_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceContext.ProtoReflect.Descriptor instead.
+func (*TraceContext) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Logging_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TraceContext) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *TraceContext) GetTraceSegmentId() string {
+	if x != nil {
+		return x.TraceSegmentId
+	}
+	return ""
+}
+
+func (x *TraceContext) GetSpanId() int32 {
+	if x != nil {
+		return x.SpanId
+	}
+	return 0
+}
+
+type LogTags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*KeyStringValuePair `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *LogTags) Reset() {
+	*x = LogTags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Logging_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogTags) ProtoMessage() {}
+
+func (x *LogTags) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Logging_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogTags.ProtoReflect.Descriptor instead.
+func (*LogTags) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Logging_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LogTags) GetData() []*KeyStringValuePair {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_skywalking_v3_Logging_proto protoreflect.FileDescriptor
+
+var file_skywalking_v3_Logging_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x33, 0x2f,
+	0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x73,
+	0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x1a, 0x1a, 0x73, 0x6b,
+	0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x33, 0x2f, 0x43, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x02, 0x0a, 0x07, 0x4c, 0x6f, 0x67,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33,
+	0x2e, 0x4c, 0x6f, 0x67, 0x44, 0x61, 0x74, 0x61, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61,
+	0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x33, 0x2e, 0x4c, 0x6f, 0x67, 0x54, 0x61, 0x67, 0x73, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0xb6, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x4c, 0x6f, 0x67, 0x48,
+	0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x67, 0x48, 0x00, 0x52,
+	0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x33, 0x2e, 0x59, 0x41, 0x4d, 0x4c, 0x4c, 0x6f, 0x67, 0x48, 0x00, 0x52, 0x04, 0x79,
+	0x61, 0x6d, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x1d,
+	0x0a, 0x07, 0x54, 0x65, 0x78, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x1d, 0x0a,
+	0x07, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x1d, 0x0a, 0x07,
+	0x59, 0x41, 0x4d, 0x4c, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x22, 0x68, 0x0a, 0x0c, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
+	0x70, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x54, 0x61, 0x67, 0x73,
+	0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x4b,
+	0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x52, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x6f, 0x67, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x17,
+	0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x00, 0x28, 0x01, 0x42, 0x26, 0x5a, 0x24, 0x73,
+	0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x65, 0x72, 0x2f, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67,
+	0x2f, 0x76, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_skywalking_v3_Logging_proto_rawDescOnce sync.Once
+	file_skywalking_v3_Logging_proto_rawDescData = file_skywalking_v3_Logging_proto_rawDesc
+)
+
+func file_skywalking_v3_Logging_proto_rawDescGZIP() []byte {
+	file_skywalking_v3_Logging_proto_rawDescOnce.Do(func() {
+		file_skywalking_v3_Logging_proto_rawDescData = protoimpl.X.CompressGZIP(file_skywalking_v3_Logging_proto_rawDescData)
+	})
+	return file_skywalking_v3_Logging_proto_rawDescData
+}
+
+var file_skywalking_v3_Logging_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_skywalking_v3_Logging_proto_goTypes = []any{
+	(*LogData)(nil),            // 0: skywalking.v3.LogData
+	(*LogDataBody)(nil),        // 1: skywalking.v3.LogDataBody
+	(*TextLog)(nil),            // 2: skywalking.v3.TextLog
+	(*JSONLog)(nil),            // 3: skywalking.v3.JSONLog
+	(*YAMLLog)(nil),            // 4: skywalking.v3.YAMLLog
+	(*TraceContext)(nil),       // 5: skywalking.v3.TraceContext
+	(*LogTags)(nil),            // 6: skywalking.v3.LogTags
+	(*KeyStringValuePair)(nil), // 7: skywalking.v3.KeyStringValuePair
+	(*Commands)(nil),           // 8: skywalking.v3.Commands
+}
+var file_skywalking_v3_Logging_proto_depIdxs = []int32{
+	1, // 0: skywalking.v3.LogData.body:type_name -> skywalking.v3.LogDataBody
+	5, // 1: skywalking.v3.LogData.traceContext:type_name -> skywalking.v3.TraceContext
+	6, // 2: skywalking.v3.LogData.tags:type_name -> skywalking.v3.LogTags
+	2, // 3: skywalking.v3.LogDataBody.text:type_name -> skywalking.v3.TextLog
+	3, // 4: skywalking.v3.LogDataBody.json:type_name -> skywalking.v3.JSONLog
+	4, // 5: skywalking.v3.LogDataBody.yaml:type_name -> skywalking.v3.YAMLLog
+	7, // 6: skywalking.v3.LogTags.data:type_name -> skywalking.v3.KeyStringValuePair
+	0, // 7: skywalking.v3.LogReportService.collect:input_type -> skywalking.v3.LogData
+	8, // 8: skywalking.v3.LogReportService.collect:output_type -> skywalking.v3.Commands
+	8, // [8:9] is the sub-list for method output_type
+	7, // [7:8] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_skywalking_v3_Logging_proto_init() }
+func file_skywalking_v3_Logging_proto_init() {
+	if File_skywalking_v3_Logging_proto != nil {
+		return
+	}
+	file_skywalking_v3_Common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_skywalking_v3_Logging_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*LogData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Logging_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*LogDataBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Logging_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*TextLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Logging_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*JSONLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Logging_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*YAMLLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Logging_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*TraceContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Logging_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*LogTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_skywalking_v3_Logging_proto_msgTypes[1].OneofWrappers = []any{
+		(*LogDataBody_Text)(nil),
+		(*LogDataBody_Json)(nil),
+		(*LogDataBody_Yaml)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_skywalking_v3_Logging_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_skywalking_v3_Logging_proto_goTypes,
+		DependencyIndexes: file_skywalking_v3_Logging_proto_depIdxs,
+		MessageInfos:      file_skywalking_v3_Logging_proto_msgTypes,
+	}.Build()
+	File_skywalking_v3_Logging_proto = out.File
+	file_skywalking_v3_Logging_proto_rawDesc = nil
+	file_skywalking_v3_Logging_proto_goTypes = nil
+	file_skywalking_v3_Logging_proto_depIdxs = nil
+}
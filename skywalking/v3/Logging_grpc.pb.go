@@ -0,0 +1,120 @@
+// Subset of the Apache SkyWalking data collect protocol (v3),
+// https://github.com/apache/skywalking-data-collect-protocol, licensed under
+// the Apache License 2.0. Field numbers must stay identical to upstream.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: skywalking/v3/Logging.proto
+
+package v3
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LogReportService_Collect_FullMethodName = "/skywalking.v3.LogReportService/collect"
+)
+
+// LogReportServiceClient is the client API for LogReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LogReportServiceClient interface {
+	// Agents stream log entries as they are written.
+	Collect(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[LogData, Commands], error)
+}
+
+type logReportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLogReportServiceClient(cc grpc.ClientConnInterface) LogReportServiceClient {
+	return &logReportServiceClient{cc}
+}
+
+func (c *logReportServiceClient) Collect(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[LogData, Commands], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LogReportService_ServiceDesc.Streams[0], LogReportService_Collect_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LogData, Commands]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LogReportService_CollectClient = grpc.ClientStreamingClient[LogData, Commands]
+
+// LogReportServiceServer is the server API for LogReportService service.
+// All implementations must embed UnimplementedLogReportServiceServer
+// for forward compatibility.
+type LogReportServiceServer interface {
+	// Agents stream log entries as they are written.
+	Collect(grpc.ClientStreamingServer[LogData, Commands]) error
+	mustEmbedUnimplementedLogReportServiceServer()
+}
+
+// UnimplementedLogReportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLogReportServiceServer struct{}
+
+func (UnimplementedLogReportServiceServer) Collect(grpc.ClientStreamingServer[LogData, Commands]) error {
+	return status.Errorf(codes.Unimplemented, "method Collect not implemented")
+}
+func (UnimplementedLogReportServiceServer) mustEmbedUnimplementedLogReportServiceServer() {}
+func (UnimplementedLogReportServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeLogReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LogReportServiceServer will
+// result in compilation errors.
+type UnsafeLogReportServiceServer interface {
+	mustEmbedUnimplementedLogReportServiceServer()
+}
+
+func RegisterLogReportServiceServer(s grpc.ServiceRegistrar, srv LogReportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLogReportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LogReportService_ServiceDesc, srv)
+}
+
+func _LogReportService_Collect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LogReportServiceServer).Collect(&grpc.GenericServerStream[LogData, Commands]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LogReportService_CollectServer = grpc.ClientStreamingServer[LogData, Commands]
+
+// LogReportService_ServiceDesc is the grpc.ServiceDesc for LogReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LogReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "skywalking.v3.LogReportService",
+	HandlerType: (*LogReportServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "collect",
+			Handler:       _LogReportService_Collect_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "skywalking/v3/Logging.proto",
+}
@@ -0,0 +1,635 @@
+// Subset of the Apache SkyWalking data collect protocol (v3),
+// https://github.com/apache/skywalking-data-collect-protocol, licensed under
+// the Apache License 2.0. Field numbers must stay identical to upstream.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: skywalking/v3/Meter.proto
+
+package v3
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Label struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Label) Reset() {
+	*x = Label{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Meter_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Label) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Label) ProtoMessage() {}
+
+func (x *Label) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Meter_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Meter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Label) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Label) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type MeterBucketValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Lower bound of the bucket.
+	Bucket             float64 `protobuf:"fixed64,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Count              int64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	IsNegativeInfinity bool    `protobuf:"varint,3,opt,name=isNegativeInfinity,proto3" json:"isNegativeInfinity,omitempty"`
+}
+
+func (x *MeterBucketValue) Reset() {
+	*x = MeterBucketValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Meter_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeterBucketValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeterBucketValue) ProtoMessage() {}
+
+func (x *MeterBucketValue) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Meter_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeterBucketValue.ProtoReflect.Descriptor instead.
+func (*MeterBucketValue) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Meter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MeterBucketValue) GetBucket() float64 {
+	if x != nil {
+		return x.Bucket
+	}
+	return 0
+}
+
+func (x *MeterBucketValue) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MeterBucketValue) GetIsNegativeInfinity() bool {
+	if x != nil {
+		return x.IsNegativeInfinity
+	}
+	return false
+}
+
+type MeterSingleValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Labels []*Label `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	Value  float64  `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MeterSingleValue) Reset() {
+	*x = MeterSingleValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Meter_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeterSingleValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeterSingleValue) ProtoMessage() {}
+
+func (x *MeterSingleValue) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Meter_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeterSingleValue.ProtoReflect.Descriptor instead.
+func (*MeterSingleValue) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Meter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MeterSingleValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeterSingleValue) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *MeterSingleValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type MeterHistogram struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Labels []*Label            `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	Values []*MeterBucketValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *MeterHistogram) Reset() {
+	*x = MeterHistogram{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Meter_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeterHistogram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeterHistogram) ProtoMessage() {}
+
+func (x *MeterHistogram) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Meter_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeterHistogram.ProtoReflect.Descriptor instead.
+func (*MeterHistogram) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Meter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MeterHistogram) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeterHistogram) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *MeterHistogram) GetValues() []*MeterBucketValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type MeterData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Metric:
+	//	*MeterData_SingleValue
+	//	*MeterData_Histogram
+	Metric          isMeterData_Metric `protobuf_oneof:"metric"`
+	Service         string             `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	ServiceInstance string             `protobuf:"bytes,4,opt,name=serviceInstance,proto3" json:"serviceInstance,omitempty"`
+	Timestamp       int64              `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *MeterData) Reset() {
+	*x = MeterData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Meter_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeterData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeterData) ProtoMessage() {}
+
+func (x *MeterData) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Meter_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeterData.ProtoReflect.Descriptor instead.
+func (*MeterData) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Meter_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *MeterData) GetMetric() isMeterData_Metric {
+	if m != nil {
+		return m.Metric
+	}
+	return nil
+}
+
+func (x *MeterData) GetSingleValue() *MeterSingleValue {
+	if x, ok := x.GetMetric().(*MeterData_SingleValue); ok {
+		return x.SingleValue
+	}
+	return nil
+}
+
+func (x *MeterData) GetHistogram() *MeterHistogram {
+	if x, ok := x.GetMetric().(*MeterData_Histogram); ok {
+		return x.Histogram
+	}
+	return nil
+}
+
+func (x *MeterData) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *MeterData) GetServiceInstance() string {
+	if x != nil {
+		return x.ServiceInstance
+	}
+	return ""
+}
+
+func (x *MeterData) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type isMeterData_Metric interface {
+	isMeterData_Metric()
+}
+
+type MeterData_SingleValue struct {
+	SingleValue *MeterSingleValue `protobuf:"bytes,1,opt,name=singleValue,proto3,oneof"`
+}
+
+type MeterData_Histogram struct {
+	Histogram *MeterHistogram `protobuf:"bytes,2,opt,name=histogram,proto3,oneof"`
+}
+
+func (*MeterData_SingleValue) isMeterData_Metric() {}
+
+func (*MeterData_Histogram) isMeterData_Metric() {}
+
+type MeterDataCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MeterData []*MeterData `protobuf:"bytes,1,rep,name=meterData,proto3" json:"meterData,omitempty"`
+}
+
+func (x *MeterDataCollection) Reset() {
+	*x = MeterDataCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Meter_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeterDataCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeterDataCollection) ProtoMessage() {}
+
+func (x *MeterDataCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Meter_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeterDataCollection.ProtoReflect.Descriptor instead.
+func (*MeterDataCollection) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Meter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MeterDataCollection) GetMeterData() []*MeterData {
+	if x != nil {
+		return x.MeterData
+	}
+	return nil
+}
+
+var File_skywalking_v3_Meter_proto protoreflect.FileDescriptor
+
+var file_skywalking_v3_Meter_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x33, 0x2f,
+	0x4d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x73, 0x6b, 0x79,
+	0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x1a, 0x1a, 0x73, 0x6b, 0x79, 0x77,
+	0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x33, 0x2f, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x31, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x70, 0x0a, 0x10, 0x4d, 0x65, 0x74,
+	0x65, 0x72, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x69,
+	0x73, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x4e, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x22, 0x6a, 0x0a, 0x10, 0x4d,
+	0x65, 0x74, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x65,
+	0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c,
+	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
+	0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x65, 0x74,
+	0x65, 0x72, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61,
+	0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6b,
+	0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x65, 0x74, 0x65,
+	0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x09, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x22, 0x4d, 0x0a, 0x13, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x65,
+	0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x32, 0xa7, 0x01, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x17,
+	0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x00, 0x28, 0x01, 0x12, 0x4f, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x2e, 0x73, 0x6b,
+	0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x65, 0x74, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x17, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x00, 0x28, 0x01, 0x42, 0x26, 0x5a, 0x24,
+	0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x2f, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e,
+	0x67, 0x2f, 0x76, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_skywalking_v3_Meter_proto_rawDescOnce sync.Once
+	file_skywalking_v3_Meter_proto_rawDescData = file_skywalking_v3_Meter_proto_rawDesc
+)
+
+func file_skywalking_v3_Meter_proto_rawDescGZIP() []byte {
+	file_skywalking_v3_Meter_proto_rawDescOnce.Do(func() {
+		file_skywalking_v3_Meter_proto_rawDescData = protoimpl.X.CompressGZIP(file_skywalking_v3_Meter_proto_rawDescData)
+	})
+	return file_skywalking_v3_Meter_proto_rawDescData
+}
+
+var file_skywalking_v3_Meter_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_skywalking_v3_Meter_proto_goTypes = []any{
+	(*Label)(nil),               // 0: skywalking.v3.Label
+	(*MeterBucketValue)(nil),    // 1: skywalking.v3.MeterBucketValue
+	(*MeterSingleValue)(nil),    // 2: skywalking.v3.MeterSingleValue
+	(*MeterHistogram)(nil),      // 3: skywalking.v3.MeterHistogram
+	(*MeterData)(nil),           // 4: skywalking.v3.MeterData
+	(*MeterDataCollection)(nil), // 5: skywalking.v3.MeterDataCollection
+	(*Commands)(nil),            // 6: skywalking.v3.Commands
+}
+var file_skywalking_v3_Meter_proto_depIdxs = []int32{
+	0, // 0: skywalking.v3.MeterSingleValue.labels:type_name -> skywalking.v3.Label
+	0, // 1: skywalking.v3.MeterHistogram.labels:type_name -> skywalking.v3.Label
+	1, // 2: skywalking.v3.MeterHistogram.values:type_name -> skywalking.v3.MeterBucketValue
+	2, // 3: skywalking.v3.MeterData.singleValue:type_name -> skywalking.v3.MeterSingleValue
+	3, // 4: skywalking.v3.MeterData.histogram:type_name -> skywalking.v3.MeterHistogram
+	4, // 5: skywalking.v3.MeterDataCollection.meterData:type_name -> skywalking.v3.MeterData
+	4, // 6: skywalking.v3.MeterReportService.collect:input_type -> skywalking.v3.MeterData
+	5, // 7: skywalking.v3.MeterReportService.collectBatch:input_type -> skywalking.v3.MeterDataCollection
+	6, // 8: skywalking.v3.MeterReportService.collect:output_type -> skywalking.v3.Commands
+	6, // 9: skywalking.v3.MeterReportService.collectBatch:output_type -> skywalking.v3.Commands
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_skywalking_v3_Meter_proto_init() }
+func file_skywalking_v3_Meter_proto_init() {
+	if File_skywalking_v3_Meter_proto != nil {
+		return
+	}
+	file_skywalking_v3_Common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_skywalking_v3_Meter_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Label); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Meter_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*MeterBucketValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Meter_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*MeterSingleValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Meter_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*MeterHistogram); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Meter_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*MeterData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Meter_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*MeterDataCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_skywalking_v3_Meter_proto_msgTypes[4].OneofWrappers = []any{
+		(*MeterData_SingleValue)(nil),
+		(*MeterData_Histogram)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_skywalking_v3_Meter_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_skywalking_v3_Meter_proto_goTypes,
+		DependencyIndexes: file_skywalking_v3_Meter_proto_depIdxs,
+		MessageInfos:      file_skywalking_v3_Meter_proto_msgTypes,
+	}.Build()
+	File_skywalking_v3_Meter_proto = out.File
+	file_skywalking_v3_Meter_proto_rawDesc = nil
+	file_skywalking_v3_Meter_proto_goTypes = nil
+	file_skywalking_v3_Meter_proto_depIdxs = nil
+}
@@ -0,0 +1,155 @@
+// Subset of the Apache SkyWalking data collect protocol (v3),
+// https://github.com/apache/skywalking-data-collect-protocol, licensed under
+// the Apache License 2.0. Field numbers must stay identical to upstream.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: skywalking/v3/Meter.proto
+
+package v3
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MeterReportService_Collect_FullMethodName      = "/skywalking.v3.MeterReportService/collect"
+	MeterReportService_CollectBatch_FullMethodName = "/skywalking.v3.MeterReportService/collectBatch"
+)
+
+// MeterReportServiceClient is the client API for MeterReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MeterReportServiceClient interface {
+	// One stream is one report period; only its first entry carries service,
+	// instance and timestamp.
+	Collect(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[MeterData, Commands], error)
+	// Each collection is a complete report on its own.
+	CollectBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[MeterDataCollection, Commands], error)
+}
+
+type meterReportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMeterReportServiceClient(cc grpc.ClientConnInterface) MeterReportServiceClient {
+	return &meterReportServiceClient{cc}
+}
+
+func (c *meterReportServiceClient) Collect(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[MeterData, Commands], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MeterReportService_ServiceDesc.Streams[0], MeterReportService_Collect_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[MeterData, Commands]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MeterReportService_CollectClient = grpc.ClientStreamingClient[MeterData, Commands]
+
+func (c *meterReportServiceClient) CollectBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[MeterDataCollection, Commands], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MeterReportService_ServiceDesc.Streams[1], MeterReportService_CollectBatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[MeterDataCollection, Commands]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MeterReportService_CollectBatchClient = grpc.ClientStreamingClient[MeterDataCollection, Commands]
+
+// MeterReportServiceServer is the server API for MeterReportService service.
+// All implementations must embed UnimplementedMeterReportServiceServer
+// for forward compatibility.
+type MeterReportServiceServer interface {
+	// One stream is one report period; only its first entry carries service,
+	// instance and timestamp.
+	Collect(grpc.ClientStreamingServer[MeterData, Commands]) error
+	// Each collection is a complete report on its own.
+	CollectBatch(grpc.ClientStreamingServer[MeterDataCollection, Commands]) error
+	mustEmbedUnimplementedMeterReportServiceServer()
+}
+
+// UnimplementedMeterReportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMeterReportServiceServer struct{}
+
+func (UnimplementedMeterReportServiceServer) Collect(grpc.ClientStreamingServer[MeterData, Commands]) error {
+	return status.Errorf(codes.Unimplemented, "method Collect not implemented")
+}
+func (UnimplementedMeterReportServiceServer) CollectBatch(grpc.ClientStreamingServer[MeterDataCollection, Commands]) error {
+	return status.Errorf(codes.Unimplemented, "method CollectBatch not implemented")
+}
+func (UnimplementedMeterReportServiceServer) mustEmbedUnimplementedMeterReportServiceServer() {}
+func (UnimplementedMeterReportServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeMeterReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MeterReportServiceServer will
+// result in compilation errors.
+type UnsafeMeterReportServiceServer interface {
+	mustEmbedUnimplementedMeterReportServiceServer()
+}
+
+func RegisterMeterReportServiceServer(s grpc.ServiceRegistrar, srv MeterReportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMeterReportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MeterReportService_ServiceDesc, srv)
+}
+
+func _MeterReportService_Collect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MeterReportServiceServer).Collect(&grpc.GenericServerStream[MeterData, Commands]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MeterReportService_CollectServer = grpc.ClientStreamingServer[MeterData, Commands]
+
+func _MeterReportService_CollectBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MeterReportServiceServer).CollectBatch(&grpc.GenericServerStream[MeterDataCollection, Commands]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MeterReportService_CollectBatchServer = grpc.ClientStreamingServer[MeterDataCollection, Commands]
+
+// MeterReportService_ServiceDesc is the grpc.ServiceDesc for MeterReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MeterReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "skywalking.v3.MeterReportService",
+	HandlerType: (*MeterReportServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "collect",
+			Handler:       _MeterReportService_Collect_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "collectBatch",
+			Handler:       _MeterReportService_CollectBatch_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "skywalking/v3/Meter.proto",
+}
@@ -0,0 +1,878 @@
+// Subset of the Apache SkyWalking data collect protocol (v3),
+// https://github.com/apache/skywalking-data-collect-protocol, licensed under
+// the Apache License 2.0. Field numbers must stay identical to upstream.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: skywalking/v3/Tracing.proto
+
+package v3
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SpanType int32
+
+const (
+	SpanType_Entry SpanType = 0
+	SpanType_Exit  SpanType = 1
+	SpanType_Local SpanType = 2
+)
+
+// Enum value maps for SpanType.
+var (
+	SpanType_name = map[int32]string{
+		0: "Entry",
+		1: "Exit",
+		2: "Local",
+	}
+	SpanType_value = map[string]int32{
+		"Entry": 0,
+		"Exit":  1,
+		"Local": 2,
+	}
+)
+
+func (x SpanType) Enum() *SpanType {
+	p := new(SpanType)
+	*p = x
+	return p
+}
+
+func (x SpanType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SpanType) Descriptor() protoreflect.EnumDescriptor {
+	return file_skywalking_v3_Tracing_proto_enumTypes[0].Descriptor()
+}
+
+func (SpanType) Type() protoreflect.EnumType {
+	return &file_skywalking_v3_Tracing_proto_enumTypes[0]
+}
+
+func (x SpanType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpanType.Descriptor instead.
+func (SpanType) EnumDescriptor() ([]byte, []int) {
+	return file_skywalking_v3_Tracing_proto_rawDescGZIP(), []int{0}
+}
+
+type RefType int32
+
+const (
+	RefType_CrossProcess RefType = 0
+	RefType_CrossThread  RefType = 1
+)
+
+// Enum value maps for RefType.
+var (
+	RefType_name = map[int32]string{
+		0: "CrossProcess",
+		1: "CrossThread",
+	}
+	RefType_value = map[string]int32{
+		"CrossProcess": 0,
+		"CrossThread":  1,
+	}
+)
+
+func (x RefType) Enum() *RefType {
+	p := new(RefType)
+	*p = x
+	return p
+}
+
+func (x RefType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefType) Descriptor() protoreflect.EnumDescriptor {
+	return file_skywalking_v3_Tracing_proto_enumTypes[1].Descriptor()
+}
+
+func (RefType) Type() protoreflect.EnumType {
+	return &file_skywalking_v3_Tracing_proto_enumTypes[1]
+}
+
+func (x RefType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefType.Descriptor instead.
+func (RefType) EnumDescriptor() ([]byte, []int) {
+	return file_skywalking_v3_Tracing_proto_rawDescGZIP(), []int{1}
+}
+
+type SpanLayer int32
+
+const (
+	SpanLayer_Unknown      SpanLayer = 0
+	SpanLayer_Database     SpanLayer = 1
+	SpanLayer_RPCFramework SpanLayer = 2
+	SpanLayer_Http         SpanLayer = 3
+	SpanLayer_MQ           SpanLayer = 4
+	SpanLayer_Cache        SpanLayer = 5
+	SpanLayer_FAAS         SpanLayer = 6
+)
+
+// Enum value maps for SpanLayer.
+var (
+	SpanLayer_name = map[int32]string{
+		0: "Unknown",
+		1: "Database",
+		2: "RPCFramework",
+		3: "Http",
+		4: "MQ",
+		5: "Cache",
+		6: "FAAS",
+	}
+	SpanLayer_value = map[string]int32{
+		"Unknown":      0,
+		"Database":     1,
+		"RPCFramework": 2,
+		"Http":         3,
+		"MQ":           4,
+		"Cache":        5,
+		"FAAS":         6,
+	}
+)
+
+func (x SpanLayer) Enum() *SpanLayer {
+	p := new(SpanLayer)
+	*p = x
+	return p
+}
+
+func (x SpanLayer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SpanLayer) Descriptor() protoreflect.EnumDescriptor {
+	return file_skywalking_v3_Tracing_proto_enumTypes[2].Descriptor()
+}
+
+func (SpanLayer) Type() protoreflect.EnumType {
+	return &file_skywalking_v3_Tracing_proto_enumTypes[2]
+}
+
+func (x SpanLayer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpanLayer.Descriptor instead.
+func (SpanLayer) EnumDescriptor() ([]byte, []int) {
+	return file_skywalking_v3_Tracing_proto_rawDescGZIP(), []int{2}
+}
+
+type SegmentObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TraceId         string        `protobuf:"bytes,1,opt,name=traceId,proto3" json:"traceId,omitempty"`
+	TraceSegmentId  string        `protobuf:"bytes,2,opt,name=traceSegmentId,proto3" json:"traceSegmentId,omitempty"`
+	Spans           []*SpanObject `protobuf:"bytes,3,rep,name=spans,proto3" json:"spans,omitempty"`
+	Service         string        `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	ServiceInstance string        `protobuf:"bytes,5,opt,name=serviceInstance,proto3" json:"serviceInstance,omitempty"`
+	IsSizeLimited   bool          `protobuf:"varint,6,opt,name=isSizeLimited,proto3" json:"isSizeLimited,omitempty"`
+}
+
+func (x *SegmentObject) Reset() {
+	*x = SegmentObject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Tracing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentObject) ProtoMessage() {}
+
+func (x *SegmentObject) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Tracing_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentObject.ProtoReflect.Descriptor instead.
+func (*SegmentObject) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Tracing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SegmentObject) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *SegmentObject) GetTraceSegmentId() string {
+	if x != nil {
+		return x.TraceSegmentId
+	}
+	return ""
+}
+
+func (x *SegmentObject) GetSpans() []*SpanObject {
+	if x != nil {
+		return x.Spans
+	}
+	return nil
+}
+
+func (x *SegmentObject) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *SegmentObject) GetServiceInstance() string {
+	if x != nil {
+		return x.ServiceInstance
+	}
+	return ""
+}
+
+func (x *SegmentObject) GetIsSizeLimited() bool {
+	if x != nil {
+		return x.IsSizeLimited
+	}
+	return false
+}
+
+type SegmentReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefType                  RefType `protobuf:"varint,1,opt,name=refType,proto3,enum=skywalking.v3.RefType" json:"refType,omitempty"`
+	TraceId                  string  `protobuf:"bytes,2,opt,name=traceId,proto3" json:"traceId,omitempty"`
+	ParentTraceSegmentId     string  `protobuf:"bytes,3,opt,name=parentTraceSegmentId,proto3" json:"parentTraceSegmentId,omitempty"`
+	ParentSpanId             int32   `protobuf:"varint,4,opt,name=parentSpanId,proto3" json:"parentSpanId,omitempty"`
+	ParentService            string  `protobuf:"bytes,5,opt,name=parentService,proto3" json:"parentService,omitempty"`
+	ParentServiceInstance    string  `protobuf:"bytes,6,opt,name=parentServiceInstance,proto3" json:"parentServiceInstance,omitempty"`
+	ParentEndpoint           string  `protobuf:"bytes,7,opt,name=parentEndpoint,proto3" json:"parentEndpoint,omitempty"`
+	NetworkAddressUsedAtPeer string  `protobuf:"bytes,8,opt,name=networkAddressUsedAtPeer,proto3" json:"networkAddressUsedAtPeer,omitempty"`
+}
+
+func (x *SegmentReference) Reset() {
+	*x = SegmentReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Tracing_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentReference) ProtoMessage() {}
+
+func (x *SegmentReference) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Tracing_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentReference.ProtoReflect.Descriptor instead.
+func (*SegmentReference) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Tracing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SegmentReference) GetRefType() RefType {
+	if x != nil {
+		return x.RefType
+	}
+	return RefType_CrossProcess
+}
+
+func (x *SegmentReference) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *SegmentReference) GetParentTraceSegmentId() string {
+	if x != nil {
+		return x.ParentTraceSegmentId
+	}
+	return ""
+}
+
+func (x *SegmentReference) GetParentSpanId() int32 {
+	if x != nil {
+		return x.ParentSpanId
+	}
+	return 0
+}
+
+func (x *SegmentReference) GetParentService() string {
+	if x != nil {
+		return x.ParentService
+	}
+	return ""
+}
+
+func (x *SegmentReference) GetParentServiceInstance() string {
+	if x != nil {
+		return x.ParentServiceInstance
+	}
+	return ""
+}
+
+func (x *SegmentReference) GetParentEndpoint() string {
+	if x != nil {
+		return x.ParentEndpoint
+	}
+	return ""
+}
+
+func (x *SegmentReference) GetNetworkAddressUsedAtPeer() string {
+	if x != nil {
+		return x.NetworkAddressUsedAtPeer
+	}
+	return ""
+}
+
+type SpanObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpanId        int32                 `protobuf:"varint,1,opt,name=spanId,proto3" json:"spanId,omitempty"`
+	ParentSpanId  int32                 `protobuf:"varint,2,opt,name=parentSpanId,proto3" json:"parentSpanId,omitempty"`
+	StartTime     int64                 `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime       int64                 `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Refs          []*SegmentReference   `protobuf:"bytes,5,rep,name=refs,proto3" json:"refs,omitempty"`
+	OperationName string                `protobuf:"bytes,6,opt,name=operationName,proto3" json:"operationName,omitempty"`
+	Peer          string                `protobuf:"bytes,7,opt,name=peer,proto3" json:"peer,omitempty"`
+	SpanType      SpanType              `protobuf:"varint,8,opt,name=spanType,proto3,enum=skywalking.v3.SpanType" json:"spanType,omitempty"`
+	SpanLayer     SpanLayer             `protobuf:"varint,9,opt,name=spanLayer,proto3,enum=skywalking.v3.SpanLayer" json:"spanLayer,omitempty"`
+	ComponentId   int32                 `protobuf:"varint,10,opt,name=componentId,proto3" json:"componentId,omitempty"`
+	IsError       bool                  `protobuf:"varint,11,opt,name=isError,proto3" json:"isError,omitempty"`
+	Tags          []*KeyStringValuePair `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	Logs          []*Log                `protobuf:"bytes,13,rep,name=logs,proto3" json:"logs,omitempty"`
+	SkipAnalysis  bool                  `protobuf:"varint,14,opt,name=skipAnalysis,proto3" json:"skipAnalysis,omitempty"`
+}
+
+func (x *SpanObject) Reset() {
+	*x = SpanObject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Tracing_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpanObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpanObject) ProtoMessage() {}
+
+func (x *SpanObject) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Tracing_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpanObject.ProtoReflect.Descriptor instead.
+func (*SpanObject) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Tracing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SpanObject) GetSpanId() int32 {
+	if x != nil {
+		return x.SpanId
+	}
+	return 0
+}
+
+func (x *SpanObject) GetParentSpanId() int32 {
+	if x != nil {
+		return x.ParentSpanId
+	}
+	return 0
+}
+
+func (x *SpanObject) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SpanObject) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SpanObject) GetRefs() []*SegmentReference {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+func (x *SpanObject) GetOperationName() string {
+	if x != nil {
+		return x.OperationName
+	}
+	return ""
+}
+
+func (x *SpanObject) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *SpanObject) GetSpanType() SpanType {
+	if x != nil {
+		return x.SpanType
+	}
+	return SpanType_Entry
+}
+
+func (x *SpanObject) GetSpanLayer() SpanLayer {
+	if x != nil {
+		return x.SpanLayer
+	}
+	return SpanLayer_Unknown
+}
+
+func (x *SpanObject) GetComponentId() int32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SpanObject) GetIsError() bool {
+	if x != nil {
+		return x.IsError
+	}
+	return false
+}
+
+func (x *SpanObject) GetTags() []*KeyStringValuePair {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SpanObject) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *SpanObject) GetSkipAnalysis() bool {
+	if x != nil {
+		return x.SkipAnalysis
+	}
+	return false
+}
+
+type Log struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time int64                 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Data []*KeyStringValuePair `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Log) Reset() {
+	*x = Log{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Tracing_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Log) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Log) ProtoMessage() {}
+
+func (x *Log) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Tracing_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Log.ProtoReflect.Descriptor instead.
+func (*Log) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Tracing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Log) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Log) GetData() []*KeyStringValuePair {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SegmentCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Segments []*SegmentObject `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *SegmentCollection) Reset() {
+	*x = SegmentCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_skywalking_v3_Tracing_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentCollection) ProtoMessage() {}
+
+func (x *SegmentCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_skywalking_v3_Tracing_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentCollection.ProtoReflect.Descriptor instead.
+func (*SegmentCollection) Descriptor() ([]byte, []int) {
+	return file_skywalking_v3_Tracing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SegmentCollection) GetSegments() []*SegmentObject {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+var File_skywalking_v3_Tracing_proto protoreflect.FileDescriptor
+
+var file_skywalking_v3_Tracing_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x33, 0x2f,
+	0x54, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x73,
+	0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x1a, 0x1a, 0x73, 0x6b,
+	0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x33, 0x2f, 0x43, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xec, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05,
+	0x73, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6b,
+	0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x70, 0x61, 0x6e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x53, 0x69, 0x7a, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x22, 0xf6, 0x02, 0x0a, 0x10, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x07,
+	0x72, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65,
+	0x66, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x72, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x18, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x22, 0x9b, 0x04, 0x0a, 0x0a, 0x53, 0x70, 0x61, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x53, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x33, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65,
+	0x65, 0x72, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73,
+	0x70, 0x61, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x70, 0x61, 0x6e, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x73, 0x6b, 0x79,
+	0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x09, 0x73, 0x70, 0x61, 0x6e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6b, 0x79, 0x77,
+	0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33,
+	0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6b,
+	0x69, 0x70, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x22, 0x50,
+	0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x4d, 0x0a, 0x11, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a,
+	0x2a, 0x0a, 0x08, 0x53, 0x70, 0x61, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x78, 0x69, 0x74, 0x10, 0x01,
+	0x12, 0x09, 0x0a, 0x05, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x10, 0x02, 0x2a, 0x2c, 0x0a, 0x07, 0x52,
+	0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x72, 0x6f, 0x73,
+	0x73, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x10, 0x01, 0x2a, 0x5f, 0x0a, 0x09, 0x53, 0x70, 0x61,
+	0x6e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x10,
+	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x50, 0x43, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x10, 0x03, 0x12, 0x06, 0x0a,
+	0x02, 0x4d, 0x51, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x61, 0x63, 0x68, 0x65, 0x10, 0x05,
+	0x12, 0x08, 0x0a, 0x04, 0x46, 0x41, 0x41, 0x53, 0x10, 0x06, 0x32, 0xaf, 0x01, 0x0a, 0x19, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x1a, 0x17, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x33, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x00, 0x28, 0x01, 0x12, 0x4c,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x12,
+	0x20, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x33, 0x2e,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x17, 0x2e, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x33, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x00, 0x42, 0x26, 0x5a, 0x24,
+	0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x2f, 0x73, 0x6b, 0x79, 0x77, 0x61, 0x6c, 0x6b, 0x69, 0x6e,
+	0x67, 0x2f, 0x76, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_skywalking_v3_Tracing_proto_rawDescOnce sync.Once
+	file_skywalking_v3_Tracing_proto_rawDescData = file_skywalking_v3_Tracing_proto_rawDesc
+)
+
+func file_skywalking_v3_Tracing_proto_rawDescGZIP() []byte {
+	file_skywalking_v3_Tracing_proto_rawDescOnce.Do(func() {
+		file_skywalking_v3_Tracing_proto_rawDescData = protoimpl.X.CompressGZIP(file_skywalking_v3_Tracing_proto_rawDescData)
+	})
+	return file_skywalking_v3_Tracing_proto_rawDescData
+}
+
+var file_skywalking_v3_Tracing_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_skywalking_v3_Tracing_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_skywalking_v3_Tracing_proto_goTypes = []any{
+	(SpanType)(0),              // 0: skywalking.v3.SpanType
+	(RefType)(0),               // 1: skywalking.v3.RefType
+	(SpanLayer)(0),             // 2: skywalking.v3.SpanLayer
+	(*SegmentObject)(nil),      // 3: skywalking.v3.SegmentObject
+	(*SegmentReference)(nil),   // 4: skywalking.v3.SegmentReference
+	(*SpanObject)(nil),         // 5: skywalking.v3.SpanObject
+	(*Log)(nil),                // 6: skywalking.v3.Log
+	(*SegmentCollection)(nil),  // 7: skywalking.v3.SegmentCollection
+	(*KeyStringValuePair)(nil), // 8: skywalking.v3.KeyStringValuePair
+	(*Commands)(nil),           // 9: skywalking.v3.Commands
+}
+var file_skywalking_v3_Tracing_proto_depIdxs = []int32{
+	5,  // 0: skywalking.v3.SegmentObject.spans:type_name -> skywalking.v3.SpanObject
+	1,  // 1: skywalking.v3.SegmentReference.refType:type_name -> skywalking.v3.RefType
+	4,  // 2: skywalking.v3.SpanObject.refs:type_name -> skywalking.v3.SegmentReference
+	0,  // 3: skywalking.v3.SpanObject.spanType:type_name -> skywalking.v3.SpanType
+	2,  // 4: skywalking.v3.SpanObject.spanLayer:type_name -> skywalking.v3.SpanLayer
+	8,  // 5: skywalking.v3.SpanObject.tags:type_name -> skywalking.v3.KeyStringValuePair
+	6,  // 6: skywalking.v3.SpanObject.logs:type_name -> skywalking.v3.Log
+	8,  // 7: skywalking.v3.Log.data:type_name -> skywalking.v3.KeyStringValuePair
+	3,  // 8: skywalking.v3.SegmentCollection.segments:type_name -> skywalking.v3.SegmentObject
+	3,  // 9: skywalking.v3.TraceSegmentReportService.collect:input_type -> skywalking.v3.SegmentObject
+	7,  // 10: skywalking.v3.TraceSegmentReportService.collectInSync:input_type -> skywalking.v3.SegmentCollection
+	9,  // 11: skywalking.v3.TraceSegmentReportService.collect:output_type -> skywalking.v3.Commands
+	9,  // 12: skywalking.v3.TraceSegmentReportService.collectInSync:output_type -> skywalking.v3.Commands
+	11, // [11:13] is the sub-list for method output_type
+	9,  // [9:11] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_skywalking_v3_Tracing_proto_init() }
+func file_skywalking_v3_Tracing_proto_init() {
+	if File_skywalking_v3_Tracing_proto != nil {
+		return
+	}
+	file_skywalking_v3_Common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_skywalking_v3_Tracing_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*SegmentObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Tracing_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*SegmentReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Tracing_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SpanObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Tracing_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Log); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_skywalking_v3_Tracing_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*SegmentCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_skywalking_v3_Tracing_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_skywalking_v3_Tracing_proto_goTypes,
+		DependencyIndexes: file_skywalking_v3_Tracing_proto_depIdxs,
+		EnumInfos:         file_skywalking_v3_Tracing_proto_enumTypes,
+		MessageInfos:      file_skywalking_v3_Tracing_proto_msgTypes,
+	}.Build()
+	File_skywalking_v3_Tracing_proto = out.File
+	file_skywalking_v3_Tracing_proto_rawDesc = nil
+	file_skywalking_v3_Tracing_proto_goTypes = nil
+	file_skywalking_v3_Tracing_proto_depIdxs = nil
+}
@@ -0,0 +1,162 @@
+// Subset of the Apache SkyWalking data collect protocol (v3),
+// https://github.com/apache/skywalking-data-collect-protocol, licensed under
+// the Apache License 2.0. Field numbers must stay identical to upstream.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: skywalking/v3/Tracing.proto
+
+package v3
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TraceSegmentReportService_Collect_FullMethodName       = "/skywalking.v3.TraceSegmentReportService/collect"
+	TraceSegmentReportService_CollectInSync_FullMethodName = "/skywalking.v3.TraceSegmentReportService/collectInSync"
+)
+
+// TraceSegmentReportServiceClient is the client API for TraceSegmentReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TraceSegmentReportServiceClient interface {
+	// Agents stream segments as soon as they finish.
+	Collect(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SegmentObject, Commands], error)
+	// Synchronous variant used by short-lived processes (e.g. FaaS).
+	CollectInSync(ctx context.Context, in *SegmentCollection, opts ...grpc.CallOption) (*Commands, error)
+}
+
+type traceSegmentReportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTraceSegmentReportServiceClient(cc grpc.ClientConnInterface) TraceSegmentReportServiceClient {
+	return &traceSegmentReportServiceClient{cc}
+}
+
+func (c *traceSegmentReportServiceClient) Collect(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SegmentObject, Commands], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TraceSegmentReportService_ServiceDesc.Streams[0], TraceSegmentReportService_Collect_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SegmentObject, Commands]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TraceSegmentReportService_CollectClient = grpc.ClientStreamingClient[SegmentObject, Commands]
+
+func (c *traceSegmentReportServiceClient) CollectInSync(ctx context.Context, in *SegmentCollection, opts ...grpc.CallOption) (*Commands, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Commands)
+	err := c.cc.Invoke(ctx, TraceSegmentReportService_CollectInSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TraceSegmentReportServiceServer is the server API for TraceSegmentReportService service.
+// All implementations must embed UnimplementedTraceSegmentReportServiceServer
+// for forward compatibility.
+type TraceSegmentReportServiceServer interface {
+	// Agents stream segments as soon as they finish.
+	Collect(grpc.ClientStreamingServer[SegmentObject, Commands]) error
+	// Synchronous variant used by short-lived processes (e.g. FaaS).
+	CollectInSync(context.Context, *SegmentCollection) (*Commands, error)
+	mustEmbedUnimplementedTraceSegmentReportServiceServer()
+}
+
+// UnimplementedTraceSegmentReportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTraceSegmentReportServiceServer struct{}
+
+func (UnimplementedTraceSegmentReportServiceServer) Collect(grpc.ClientStreamingServer[SegmentObject, Commands]) error {
+	return status.Errorf(codes.Unimplemented, "method Collect not implemented")
+}
+func (UnimplementedTraceSegmentReportServiceServer) CollectInSync(context.Context, *SegmentCollection) (*Commands, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectInSync not implemented")
+}
+func (UnimplementedTraceSegmentReportServiceServer) mustEmbedUnimplementedTraceSegmentReportServiceServer() {
+}
+func (UnimplementedTraceSegmentReportServiceServer) testEmbeddedByValue() {}
+
+// UnsafeTraceSegmentReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TraceSegmentReportServiceServer will
+// result in compilation errors.
+type UnsafeTraceSegmentReportServiceServer interface {
+	mustEmbedUnimplementedTraceSegmentReportServiceServer()
+}
+
+func RegisterTraceSegmentReportServiceServer(s grpc.ServiceRegistrar, srv TraceSegmentReportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTraceSegmentReportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TraceSegmentReportService_ServiceDesc, srv)
+}
+
+func _TraceSegmentReportService_Collect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TraceSegmentReportServiceServer).Collect(&grpc.GenericServerStream[SegmentObject, Commands]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TraceSegmentReportService_CollectServer = grpc.ClientStreamingServer[SegmentObject, Commands]
+
+func _TraceSegmentReportService_CollectInSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentCollection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraceSegmentReportServiceServer).CollectInSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TraceSegmentReportService_CollectInSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraceSegmentReportServiceServer).CollectInSync(ctx, req.(*SegmentCollection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TraceSegmentReportService_ServiceDesc is the grpc.ServiceDesc for TraceSegmentReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TraceSegmentReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "skywalking.v3.TraceSegmentReportService",
+	HandlerType: (*TraceSegmentReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "collectInSync",
+			Handler:    _TraceSegmentReportService_CollectInSync_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "collect",
+			Handler:       _TraceSegmentReportService_Collect_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "skywalking/v3/Tracing.proto",
+}